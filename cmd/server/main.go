@@ -6,14 +6,18 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/agungramananda/sosmed-todolist/config"
 	"github.com/agungramananda/sosmed-todolist/docs"
 	"github.com/agungramananda/sosmed-todolist/internal/common/custom_validator"
 	"github.com/agungramananda/sosmed-todolist/internal/common/logger"
+	"github.com/agungramananda/sosmed-todolist/internal/common/worker"
 	"github.com/agungramananda/sosmed-todolist/internal/database/postgres"
 	"github.com/agungramananda/sosmed-todolist/internal/domain"
+	"github.com/agungramananda/sosmed-todolist/internal/storage"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 )
@@ -24,6 +28,12 @@ import (
 // @termsOfService http://swagger.io/terms/
 
 // @BasePath /api/v1
+// @security BearerAuth
+
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Access token from /auth/login, sent as "Bearer <token>"
 
 func main() {
 	config := config.New()
@@ -32,18 +42,27 @@ func main() {
 	logger := logger.New()
 	validator := custom_validator.NewCustomValidator(validator.New(validator.WithRequiredStructEnabled()))
 
+	if config.AuthConf.JWTSecret == "" {
+		logger.Fatal().Msg("JWT_SECRET is not set")
+	}
+
 	db, err := postgres.New(logger, config.DbConf)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("failed to initialized db")
 	}
 
+	store, err := storage.NewLocal(config.StorageConf.Dir)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to initialized storage")
+	}
+
 	e := echo.New()
-	domain.InitDomain(db,e,logger, validator)
+	jobs := domain.InitDomain(db,e,logger, validator, store, config.ReminderConf, config.AuthConf)
 	
 	e.HideBanner = true
 	e.HidePort = true
 
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
 	go func (){
@@ -53,10 +72,21 @@ func main() {
 		}
 	}()
 
+	var wg sync.WaitGroup
+
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job domain.Job) {
+			defer wg.Done()
+			worker.Every(ctx, logger, job.Name, job.Interval, job.Run)
+		}(job)
+	}
+
 	<-ctx.Done()
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	if err := e.Shutdown(ctx); err != nil {
 		logger.Fatal().Err(err).Msg(err.Error())
 	}
+	wg.Wait()
 }
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Command webhooksink is a local stand-in for a webhook receiver. It logs
+// every request it receives and can fail the first requests to exercise the
+// retries of the sender.
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+func main() {
+	addr := flag.String("addr", ":9090", "address to listen on")
+	failFirst := flag.Int64("fail-first", 0, "respond 503 to the first n requests")
+	flag.Parse()
+
+	var received atomic.Int64
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		n := received.Add(1)
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		headers := []string{}
+		for name, values := range r.Header {
+			if strings.HasPrefix(name, "X-") {
+				headers = append(headers, name+"="+strings.Join(values, ","))
+			}
+		}
+		sort.Strings(headers)
+
+		log.Printf("#%d %s %s %s\n%s", n, r.Method, r.URL.Path, strings.Join(headers, " "), body)
+
+		if n <= *failFirst {
+			http.Error(w, "failing on purpose", http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	log.Printf("webhook sink listening at %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
@@ -0,0 +1,9 @@
+package config
+
+import "time"
+
+type AuthConfig struct {
+	JWTSecret  string
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
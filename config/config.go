@@ -2,6 +2,8 @@ package config
 
 import (
 	"os"
+	"strings"
+	"time"
 )
 
 var conf Config
@@ -13,6 +15,9 @@ type Config struct {
 	SwaggerHost    	string
 	SwaggerPort		string
 	DbConf         	*DBConfig
+	StorageConf		*StorageConfig
+	ReminderConf	*ReminderConfig
+	AuthConf		*AuthConfig
 }
 
 func New() *Config {
@@ -29,6 +34,17 @@ func New() *Config {
 			Password: 	os.Getenv("DB_PASSWORD"),
 			Database: 	os.Getenv("DB_NAME"),
 		},
+		StorageConf:		&StorageConfig{
+			Dir:		os.Getenv("STORAGE_DIR"),
+		},
+		ReminderConf:		&ReminderConfig{
+			WebhookURLs:	splitList(os.Getenv("REMINDER_WEBHOOK_URLS")),
+		},
+		AuthConf:			&AuthConfig{
+			JWTSecret:	os.Getenv("JWT_SECRET"),
+			AccessTTL:	durationOr(os.Getenv("JWT_ACCESS_TTL"), 15*time.Minute),
+			RefreshTTL:	durationOr(os.Getenv("JWT_REFRESH_TTL"), 30*24*time.Hour),
+		},
 	}
 
 	return &conf
@@ -36,4 +52,28 @@ func New() *Config {
 
 func Get() *Config {
 	return &conf
-}
\ No newline at end of file
+}
+
+// splitList reads a comma separated environment value, skipping blanks.
+func splitList(value string) []string {
+	items := []string{}
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// durationOr reads a duration such as "15m" or "720h", falling back when the
+// value is blank or malformed.
+func durationOr(value string, fallback time.Duration) time.Duration {
+	duration, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil || duration <= 0 {
+		return fallback
+	}
+
+	return duration
+}
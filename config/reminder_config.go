@@ -0,0 +1,5 @@
+package config
+
+type ReminderConfig struct {
+	WebhookURLs []string
+}
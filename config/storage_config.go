@@ -0,0 +1,5 @@
+package config
+
+type StorageConfig struct {
+	Dir string
+}
@@ -16,44 +16,31 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/brands": {
+        "/api-keys": {
             "get": {
+                "description": "Needs a login, API keys cannot manage keys.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Brand"
-                ],
-                "summary": "Get all brands",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Keyword to search",
-                        "name": "keyword",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Number of entities per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    }
+                    "API Key"
                 ],
+                "summary": "Get the API keys of the logged in user",
                 "responses": {
                     "200": {
-                        "description": "Successfully fetched all brands",
+                        "description": "Successfully fetched all api keys",
                         "schema": {
-                            "$ref": "#/definitions/brands.ListofBrands"
+                            "$ref": "#/definitions/apikeys.ListofAPIKeys"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Called with an API key",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
@@ -67,6 +54,7 @@ const docTemplate = `{
                 }
             },
             "post": {
+                "description": "Scopes are tasks:read, tasks:write, brands:read, brands:write, platforms:read, platforms:write, tags:read, tags:write, webhooks:read and webhooks:write. The key is only returned in this response, send it as \"Authorization: Bearer \u003ckey\u003e\".",
                 "consumes": [
                     "application/json"
                 ],
@@ -74,25 +62,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Brand"
+                    "API Key"
                 ],
-                "summary": "Create a new brand",
+                "summary": "Create an API key",
                 "parameters": [
                     {
-                        "description": "Brand details",
+                        "description": "API key details",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/brands.BrandRequestPayload"
+                            "$ref": "#/definitions/apikeys.APIKeyRequestPayload"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Brand successfully created",
+                        "description": "API key successfully created",
                         "schema": {
-                            "$ref": "#/definitions/httpres.BaseResponse"
+                            "$ref": "#/definitions/apikeys.CreatedAPIKeyDetails"
                         }
                     },
                     "400": {
@@ -101,6 +89,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Called with an API key",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -110,29 +110,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/brands/{id}": {
-            "get": {
+        "/api-keys/{key_id}": {
+            "delete": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Brand"
+                    "API Key"
                 ],
-                "summary": "Get a single brand by ID",
+                "summary": "Revoke an API key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Brand ID",
-                        "name": "id",
+                        "description": "API Key ID",
+                        "name": "key_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully fetched the brand",
+                        "description": "API key revoked successfully",
                         "schema": {
-                            "$ref": "#/definitions/brands.BrandDetails"
+                            "$ref": "#/definitions/httpres.BaseResponse"
                         }
                     },
                     "400": {
@@ -141,8 +141,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Called with an API key",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Brand not found",
+                        "description": "API key not found",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
@@ -154,8 +166,12 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "security": [],
+                "description": "Returns a short lived access token for the Authorization header and a refresh token to get a new pair with.",
                 "consumes": [
                     "application/json"
                 ],
@@ -163,30 +179,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Brand"
+                    "Auth"
                 ],
-                "summary": "Update an existing brand",
+                "summary": "Log in with email and password",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Brand ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.LoginPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Logged in successfully",
+                        "schema": {
+                            "$ref": "#/definitions/users.TokenDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email or password",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
                     },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "security": [],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Revoke a refresh token",
+                "parameters": [
                     {
-                        "description": "Updated brand details",
+                        "description": "Refresh token",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/brands.BrandRequestPayload"
+                            "$ref": "#/definitions/users.RefreshPayload"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Brand updated successfully",
+                        "description": "Logged out successfully",
                         "schema": {
                             "$ref": "#/definitions/httpres.BaseResponse"
                         }
@@ -197,8 +258,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Brand not found",
+                    "401": {
+                        "description": "Invalid or expired refresh token",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
@@ -210,29 +271,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "security": [],
+                "description": "A refresh token can be used once.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Brand"
+                    "Auth"
                 ],
-                "summary": "Delete a brand by ID",
+                "summary": "Trade a refresh token for a new pair of tokens",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Brand ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Refresh token",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.RefreshPayload"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Brand deleted successfully",
+                        "description": "Tokens refreshed successfully",
                         "schema": {
-                            "$ref": "#/definitions/httpres.BaseResponse"
+                            "$ref": "#/definitions/users.TokenDetails"
                         }
                     },
                     "400": {
@@ -241,8 +311,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Brand not found",
+                    "401": {
+                        "description": "Invalid, expired or spent refresh token",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
@@ -256,15 +326,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/platforms": {
+        "/auth/register": {
+            "post": {
+                "security": [],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Create a user account",
+                "parameters": [
+                    {
+                        "description": "Account details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/users.RegisterPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User successfully registered",
+                        "schema": {
+                            "$ref": "#/definitions/users.UserDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or email already registered",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/brands": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Platform"
+                    "Brand"
                 ],
-                "summary": "Get all platforms",
+                "summary": "Get all brands",
                 "parameters": [
                     {
                         "type": "string",
@@ -272,6 +388,18 @@ const docTemplate = `{
                         "name": "keyword",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort keys, prefix with - for descending (brand_id, brand, created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from a previous next_cursor or prev_cursor, page is ignored when set",
+                        "name": "cursor",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "Number of entities per page",
@@ -287,9 +415,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully fetched all platforms",
+                        "description": "Successfully fetched all brands",
                         "schema": {
-                            "$ref": "#/definitions/platforms.ListofPlatforms"
+                            "$ref": "#/definitions/brands.ListofBrands"
                         }
                     },
                     "400": {
@@ -314,23 +442,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Platform"
+                    "Brand"
                 ],
-                "summary": "Create a new platform",
+                "summary": "Create a new brand",
                 "parameters": [
                     {
-                        "description": "Platform details",
+                        "description": "Brand details",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/platforms.PlatformRequestPayload"
+                            "$ref": "#/definitions/brands.BrandRequestPayload"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Platform successfully created",
+                        "description": "Brand successfully created",
                         "schema": {
                             "$ref": "#/definitions/httpres.BaseResponse"
                         }
@@ -341,6 +469,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Role may not manage brands",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -350,19 +484,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/platforms/{id}": {
+        "/brands/{id}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Platform"
+                    "Brand"
                 ],
-                "summary": "Get a single platform by ID",
+                "summary": "Get a single brand by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Platform ID",
+                        "description": "Brand ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -370,9 +504,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully fetched the platform",
+                        "description": "Successfully fetched the brand",
                         "schema": {
-                            "$ref": "#/definitions/platforms.PlatformDetails"
+                            "$ref": "#/definitions/brands.BrandDetails"
                         }
                     },
                     "400": {
@@ -382,7 +516,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Platform not found",
+                        "description": "Brand not found",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
@@ -403,30 +537,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Platform"
+                    "Brand"
                 ],
-                "summary": "Update an existing platform",
+                "summary": "Update an existing brand",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Platform ID",
+                        "description": "Brand ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated platform details",
+                        "description": "Updated brand details",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/platforms.PlatformRequestPayload"
+                            "$ref": "#/definitions/brands.BrandRequestPayload"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Platform updated successfully",
+                        "description": "Brand updated successfully",
                         "schema": {
                             "$ref": "#/definitions/httpres.BaseResponse"
                         }
@@ -437,8 +571,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Role may not manage brands",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Platform not found",
+                        "description": "Brand not found",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
@@ -456,13 +596,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Platform"
+                    "Brand"
                 ],
-                "summary": "Delete a platform by ID",
+                "summary": "Delete a brand by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Platform ID",
+                        "description": "Brand ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -470,7 +610,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Platform deleted successfully",
+                        "description": "Brand deleted successfully",
                         "schema": {
                             "$ref": "#/definitions/httpres.BaseResponse"
                         }
@@ -481,8 +621,83 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Platform not found",
+                    "403": {
+                        "description": "Role may not manage brands",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Brand"
+                ],
+                "summary": "Partially update an existing brand",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Brand ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Brand fields to change",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/brands.BrandPatchPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Brand updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not manage brands",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Brand not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
@@ -496,22 +711,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks": {
+        "/me/tasks": {
             "get": {
+                "description": "Tasks that are not completed yet, in the current workspace, the ones due first at the top.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Task"
                 ],
-                "summary": "Get all tasks",
+                "summary": "Get the open tasks assigned to the caller",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Keyword to search",
-                        "name": "keyword",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
                         "description": "Number of entities per page",
@@ -527,7 +737,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully fetched all tasks",
+                        "description": "Successfully fetched my tasks",
                         "schema": {
                             "$ref": "#/definitions/tasks.ListofTasks"
                         }
@@ -545,38 +755,33 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/members": {
+            "get": {
+                "description": "Only owners and managers may list the members.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Task"
+                    "Workspace"
                 ],
-                "summary": "Create a new task",
-                "parameters": [
-                    {
-                        "description": "Task details",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
+                "summary": "Get the members of the workspace",
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched all members",
                         "schema": {
-                            "$ref": "#/definitions/tasks.TaskRequestPayload"
+                            "$ref": "#/definitions/workspaces.ListofMembers"
                         }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Task successfully created",
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
                         "schema": {
-                            "$ref": "#/definitions/httpres.BaseResponse"
+                            "$ref": "#/definitions/httpres.ErrorResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "403": {
+                        "description": "Role may not list members",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
@@ -588,41 +793,57 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/tasks/{id}": {
-            "get": {
+            },
+            "post": {
+                "description": "Only owners may add members.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Task"
+                    "Workspace"
                 ],
-                "summary": "Get a single task by ID",
+                "summary": "Add a registered user to the workspace",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Task ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Member details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/workspaces.MemberRequestPayload"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Successfully fetched the task",
+                    "201": {
+                        "description": "Member successfully added",
                         "schema": {
-                            "$ref": "#/definitions/tasks.TaskDetails"
+                            "$ref": "#/definitions/httpres.BaseResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request or already a member",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not add members",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Task not found",
+                        "description": "User not found",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
@@ -634,8 +855,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/members/{user_id}": {
             "put": {
+                "description": "Only owners may change roles, and the last owner cannot give up the owner role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -643,42 +867,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Task"
+                    "Workspace"
                 ],
-                "summary": "Update an existing task",
+                "summary": "Change the role of a member",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
-                        "name": "id",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated task details",
+                        "description": "New role",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tasks.TaskRequestPayload"
+                            "$ref": "#/definitions/workspaces.RolePayload"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Task updated successfully",
+                        "description": "Member updated successfully",
                         "schema": {
                             "$ref": "#/definitions/httpres.BaseResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request or last owner",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change roles",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Task not found",
+                        "description": "Member not found",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
@@ -692,37 +928,50 @@ const docTemplate = `{
                 }
             },
             "delete": {
+                "description": "Only owners may remove members, the API keys of the member in the workspace are revoked.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Task"
+                    "Workspace"
                 ],
-                "summary": "Delete a task by ID",
+                "summary": "Remove a member from the workspace",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
-                        "name": "id",
+                        "description": "User ID",
+                        "name": "user_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Task deleted successfully",
+                        "description": "Member removed successfully",
                         "schema": {
                             "$ref": "#/definitions/httpres.BaseResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request or last owner",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not remove members",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Task not found",
+                        "description": "Member not found",
                         "schema": {
                             "$ref": "#/definitions/httpres.ErrorResponse"
                         }
@@ -735,202 +984,5142 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "brands.BrandDetails": {
-            "type": "object",
-            "properties": {
-                "brand": {
-                    "type": "string"
-                },
-                "brand_id": {
-                    "type": "integer"
-                }
-            }
-        },
-        "brands.BrandRequestPayload": {
-            "type": "object",
-            "required": [
-                "brand"
-            ],
-            "properties": {
-                "brand": {
-                    "type": "string",
-                    "maxLength": 200,
-                    "minLength": 1
-                }
-            }
         },
-        "brands.ListofBrands": {
-            "type": "object",
-            "properties": {
-                "brands": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/brands.BrandDetails"
+        "/platforms": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Platform"
+                ],
+                "summary": "Get all platforms",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Keyword to search",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort keys, prefix with - for descending (platform_id, platform, created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from a previous next_cursor or prev_cursor, page is ignored when set",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of entities per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
                     }
-                },
-                "meta": {
-                    "$ref": "#/definitions/httpres.ListPagination"
-                }
-            }
-        },
-        "httpres.BaseResponse": {
-            "type": "object",
-            "properties": {
-                "data": {},
-                "message": {
-                    "type": "string"
-                }
-            }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched all platforms",
+                        "schema": {
+                            "$ref": "#/definitions/platforms.ListofPlatforms"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Platform"
+                ],
+                "summary": "Create a new platform",
+                "parameters": [
+                    {
+                        "description": "Platform details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/platforms.PlatformRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Platform successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not manage platforms",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/platforms/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Platform"
+                ],
+                "summary": "Get a single platform by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched the platform",
+                        "schema": {
+                            "$ref": "#/definitions/platforms.PlatformDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Platform not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Platform"
+                ],
+                "summary": "Update an existing platform",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated platform details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/platforms.PlatformRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Platform updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not manage platforms",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Platform not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Platform"
+                ],
+                "summary": "Delete a platform by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Platform deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not manage platforms",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Platform not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Platform"
+                ],
+                "summary": "Partially update an existing platform",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Platform fields to change",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/platforms.PlatformPatchPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Platform updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not manage platforms",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Platform not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/series": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Get all recurring task series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Keyword to search",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of entities per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched all series",
+                        "schema": {
+                            "$ref": "#/definitions/series.ListofSeries"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "The rrule follows RFC 5545 (FREQ=DAILY|WEEKLY|MONTHLY with INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL). Tasks are created for the occurrences of the next 8 weeks and the horizon moves forward every hour.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Create a recurring task series",
+                "parameters": [
+                    {
+                        "description": "Series details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/series.SeriesRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Series successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not create tasks",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/series/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Get a single recurring task series by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched the series",
+                        "schema": {
+                            "$ref": "#/definitions/series.SeriesDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Series not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "With scope=this only the task of the occurrence is changed and rrule and start_date are ignored. With scope=future the series is split, the occurrence and every later one follow the new details and rrule starting at the occurrence, open tasks already created for them are replaced and completed ones are kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Update one occurrence or all future occurrences of a series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "this",
+                            "future"
+                        ],
+                        "type": "string",
+                        "description": "Occurrences to change",
+                        "name": "scope",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Occurrence date (YYYY-MM-DD)",
+                        "name": "occurrence",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated series details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/series.SeriesRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Series updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the series",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Series not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "A cancelled occurrence is never created again. Completed tasks are kept when future occurrences are cancelled.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Series"
+                ],
+                "summary": "Cancel one occurrence or all future occurrences of a series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Series ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "this",
+                            "future"
+                        ],
+                        "type": "string",
+                        "description": "Occurrences to cancel",
+                        "name": "scope",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Occurrence date (YYYY-MM-DD)",
+                        "name": "occurrence",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Series occurrences cancelled successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the series",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Series not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Get all tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Keyword to search",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort keys, prefix with - for descending (tag_id, tag, created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from a previous next_cursor or prev_cursor, page is ignored when set",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of entities per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched all tags",
+                        "schema": {
+                            "$ref": "#/definitions/tags.ListofTags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Create a new tag",
+                "parameters": [
+                    {
+                        "description": "Tag details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tags.TagRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Tag successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not manage tags",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Get a single tag by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched the tag",
+                        "schema": {
+                            "$ref": "#/definitions/tags.TagDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Update an existing tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated tag details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tags.TagRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not manage tags",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Delete a tag by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not manage tags",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Partially update an existing tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag fields to change",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tags.TagPatchPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not manage tags",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Get all tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Keyword to search",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "Pending",
+                                "Completed",
+                                "Scheduled"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by brand ID",
+                        "name": "brand_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by platform ID, any platform of a task matches",
+                        "name": "platform_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest due date (YYYY-MM-DD)",
+                        "name": "due_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest due date (YYYY-MM-DD)",
+                        "name": "due_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum payment",
+                        "name": "min_payment",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum payment",
+                        "name": "max_payment",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by hashtag, matches tasks with any of them",
+                        "name": "hashtag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by whether the task is past its due date and not completed, refreshed every 5 minutes",
+                        "name": "overdue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by whether the task has open checklist items",
+                        "name": "incomplete_checklist",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by tag name, repeatable",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "Whether a task needs any or all of the tags (default any)",
+                        "name": "tag_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by assignee, me, unassigned or a user ID",
+                        "name": "assignee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort keys, prefix with - for descending (task_id, title, brand, platform, due_date, payment, status, created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from a previous next_cursor or prev_cursor, page is ignored when set",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of entities per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched all tasks",
+                        "schema": {
+                            "$ref": "#/definitions/tasks.ListofTasks"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Create a new task",
+                "parameters": [
+                    {
+                        "description": "Task details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.TaskRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Task successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not create tasks",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/bulk": {
+            "post": {
+                "description": "Sets the status (status), shifts the due date by a number of days (days), moves the tasks to another platform (platform_id) or deletes them. All tasks are changed in one transaction, a task that cannot be changed is reported in its result and left as it was.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Apply one operation to many tasks",
+                "parameters": [
+                    {
+                        "description": "Tasks and operation",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.TaskBulkPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Operation applied",
+                        "schema": {
+                            "$ref": "#/definitions/tasks.TaskBulkResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not run the operation",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/calendar": {
+            "get": {
+                "description": "Every bucket between from and to is returned, including empty ones. Weeks start on Monday and the first and last buckets are clipped to the range, which may span at most 366 days.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Get tasks grouped into day, week or month buckets by due date",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First due date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last due date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Bucket size, defaults to day",
+                        "name": "granularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "Pending",
+                                "Completed",
+                                "Scheduled"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by brand ID",
+                        "name": "brand_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by platform ID, any platform of a task matches",
+                        "name": "platform_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched the task calendar",
+                        "schema": {
+                            "$ref": "#/definitions/tasks.TaskCalendar"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/export.csv": {
+            "get": {
+                "description": "Streams every task matching the filters, without the page size limit of the list endpoint.",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Export tasks as CSV",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Keyword to search",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "Pending",
+                                "Completed",
+                                "Scheduled"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by brand ID",
+                        "name": "brand_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by platform ID, any platform of a task matches",
+                        "name": "platform_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest due date (YYYY-MM-DD)",
+                        "name": "due_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest due date (YYYY-MM-DD)",
+                        "name": "due_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum payment",
+                        "name": "min_payment",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum payment",
+                        "name": "max_payment",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by hashtag, matches tasks with any of them",
+                        "name": "hashtag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by whether the task is past its due date and not completed, refreshed every 5 minutes",
+                        "name": "overdue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by whether the task has open checklist items",
+                        "name": "incomplete_checklist",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by tag name, repeatable",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "Whether a task needs any or all of the tags (default any)",
+                        "name": "tag_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by assignee, me, unassigned or a user ID",
+                        "name": "assignee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort keys, prefix with - for descending (task_id, title, brand, platform, due_date, payment, status, created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV with a header row",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/feed.ics": {
+            "get": {
+                "description": "Returns an RFC 5545 calendar with one all-day event per task on its due date, meant to be subscribed to from a calendar app. Event UIDs are stable so subscribed clients update events in place.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Get tasks as an iCalendar feed",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by brand ID",
+                        "name": "brand_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by platform ID, any platform of a task matches",
+                        "name": "platform_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "iCalendar feed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/import": {
+            "post": {
+                "description": "The header row names the columns title, brand, platform, due_date, payment, status and optionally caption, hashtags (separated by spaces or commas) and cta_url. Brands and platforms are given by name or by ID, the brand_id and platform_id columns of an export are accepted too and its task_id column is ignored, so an export can be imported back. Every row is validated like a created task, then the valid rows are created in one transaction and invalid rows are reported. With dry_run=true nothing is created.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Import tasks from a CSV file",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only validate the rows",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "file",
+                        "description": "CSV file, at most 5 MB and 1000 rows",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rows validated (dry run)",
+                        "schema": {
+                            "$ref": "#/definitions/tasks.TaskImportResult"
+                        }
+                    },
+                    "201": {
+                        "description": "Valid rows imported",
+                        "schema": {
+                            "$ref": "#/definitions/tasks.TaskImportResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not create tasks",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Get a single task by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched the task",
+                        "schema": {
+                            "$ref": "#/definitions/tasks.TaskDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Update an existing task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated task details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.TaskRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Delete a task by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not delete tasks",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Partially update an existing task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task fields to change",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.TaskPatchPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/history": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Get the status transitions of a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched the task status history",
+                        "schema": {
+                            "$ref": "#/definitions/tasks.ListofTaskStatusHistory"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/platforms/{platform_id}": {
+            "put": {
+                "description": "The status follows the same transitions as the status of the task and is added to its status history. The status on the primary platform is the status of the task and changes it too.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Set the status and payment of a task on one of its platforms",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "platform_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status and payment on the platform",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tasks.TaskPlatformPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task platform updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or transition not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task or task platform not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/reopen": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task"
+                ],
+                "summary": "Reopen a completed task, moving it back to Pending",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task reopened successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task_id}/attachments": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachment"
+                ],
+                "summary": "Get all attachments of a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched all attachments",
+                        "schema": {
+                            "$ref": "#/definitions/attachments.ListofAttachments"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachment"
+                ],
+                "summary": "Upload an image or video to a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image or video file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Attachment successfully uploaded",
+                        "schema": {
+                            "$ref": "#/definitions/attachments.AttachmentDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task_id}/attachments/{attachment_id}": {
+            "get": {
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Attachment"
+                ],
+                "summary": "Download the content of an attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attachment content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Attachment not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachment"
+                ],
+                "summary": "Delete an attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attachment deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Attachment not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task_id}/checklist": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklist"
+                ],
+                "summary": "Get the checklist of a task in order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched the checklist",
+                        "schema": {
+                            "$ref": "#/definitions/checklist.ListofChecklistItems"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "A task cannot be completed while one of its required items is open.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklist"
+                ],
+                "summary": "Add an item to the end of the checklist of a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Checklist Item Request Payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/checklist.ChecklistRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Checklist item successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task_id}/checklist/order": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklist"
+                ],
+                "summary": "Reorder the checklist of a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Every item ID of the checklist in the new order",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/checklist.ChecklistOrderPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Checklist reordered successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task_id}/checklist/{item_id}": {
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklist"
+                ],
+                "summary": "Delete a checklist item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Checklist Item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Checklist item deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Checklist item not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Checklist"
+                ],
+                "summary": "Partially update a checklist item, such as ticking it off",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Checklist Item ID",
+                        "name": "item_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/checklist.ChecklistPatchPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Checklist item updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Checklist item not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task_id}/comments": {
+            "get": {
+                "description": "Every thread holds all of its replies. A deleted comment that still has replies is returned with deleted set and without its author and body.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Get the comment threads of a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort keys, prefix with - for descending (comment_id, created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from a previous next_cursor or prev_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of threads per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched comments",
+                        "schema": {
+                            "$ref": "#/definitions/comments.ListofComments"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "The caller is recorded as the author. A reply to a reply is added to the same thread.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Comment on a task or reply to a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment Request Payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/comments.CommentRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Comment successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task or parent comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task_id}/comments/{comment_id}": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Edit the body of a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New body of the comment",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/comments.CommentEditPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comment updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Caller did not write the comment",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Delete a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comment deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Caller did not write the comment",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task_id}/reminders": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reminder"
+                ],
+                "summary": "Get all reminders of a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched all reminders",
+                        "schema": {
+                            "$ref": "#/definitions/reminders.ListofReminders"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "The offset is how long before the due date the reminder fires, such as \"24h before\" or \"90m\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reminder"
+                ],
+                "summary": "Create a reminder for a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reminder Request Payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/reminders.ReminderRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Reminder successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task_id}/reminders/{reminder_id}": {
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reminder"
+                ],
+                "summary": "Delete a reminder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reminder ID",
+                        "name": "reminder_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reminder deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not change the task",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Reminder not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{task_id}/reminders/{reminder_id}/deliveries": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reminder"
+                ],
+                "summary": "Get the webhook deliveries of a reminder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reminder ID",
+                        "name": "reminder_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched all deliveries",
+                        "schema": {
+                            "$ref": "#/definitions/reminders.ListofDeliveries"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Reminder not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Get the logged in user",
+                "responses": {
+                    "200": {
+                        "description": "User fetched successfully",
+                        "schema": {
+                            "$ref": "#/definitions/users.UserDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Get all webhook subscriptions",
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched all webhooks",
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.ListofWebhooks"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Every delivery is signed: X-Webhook-Signature is \"sha256=\" followed by the hex HMAC-SHA256 of \"\u003cX-Webhook-Timestamp\u003e.\u003cbody\u003e\" keyed with the secret.\nEvents: task.created, task.updated, task.completed, task.deleted, brand.created, brand.updated, brand.deleted, platform.created, platform.updated, platform.deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Subscribe a URL to events",
+                "parameters": [
+                    {
+                        "description": "Webhook Request Payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.WebhookRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Webhook successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not manage webhooks",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Get a single webhook subscription by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched the webhook",
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.WebhookDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Update an existing webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated webhook details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.WebhookRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not manage webhooks",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Delete a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Role may not manage webhooks",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Get the deliveries of a webhook subscription with every attempt",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of entities per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched all deliveries",
+                        "schema": {
+                            "$ref": "#/definitions/webhooks.ListofDeliveries"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspaces": {
+            "get": {
+                "description": "Send the ID of one as the X-Workspace-ID header to work in it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspace"
+                ],
+                "summary": "Get the workspaces of the logged in user",
+                "responses": {
+                    "200": {
+                        "description": "Successfully fetched all workspaces",
+                        "schema": {
+                            "$ref": "#/definitions/workspaces.ListofWorkspaces"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Called with an API key",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workspace"
+                ],
+                "summary": "Create a workspace owned by the logged in user",
+                "parameters": [
+                    {
+                        "description": "Workspace details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/workspaces.WorkspaceRequestPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Workspace successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/workspaces.WorkspaceDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid access token",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Called with an API key",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpres.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "apikeys.APIKeyDetails": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:00:00Z"
+                },
+                "key_id": {
+                    "type": "integer"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Publishing bot"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "stk_Xk2mQ9aB"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "tasks:read",
+                        "tasks:write"
+                    ]
+                },
+                "workspace_id": {
+                    "description": "WorkspaceID is the workspace the key works in.",
+                    "type": "integer"
+                }
+            }
+        },
+        "apikeys.APIKeyRequestPayload": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Publishing bot"
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "tasks:read",
+                        "tasks:write"
+                    ]
+                }
+            }
+        },
+        "apikeys.CreatedAPIKeyDetails": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:00:00Z"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "stk_Xk2mQ9aBv1t0Yd8Qw3eRr5tTy7uUi9oOp1aAs3dDf5g"
+                },
+                "key_id": {
+                    "type": "integer"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Publishing bot"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "stk_Xk2mQ9aB"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "tasks:read",
+                        "tasks:write"
+                    ]
+                },
+                "workspace_id": {
+                    "description": "WorkspaceID is the workspace the key works in.",
+                    "type": "integer"
+                }
+            }
+        },
+        "apikeys.ListofAPIKeys": {
+            "type": "object",
+            "properties": {
+                "api_keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/apikeys.APIKeyDetails"
+                    }
+                }
+            }
+        },
+        "attachments.AttachmentDetails": {
+            "type": "object",
+            "properties": {
+                "attachment_id": {
+                    "type": "integer"
+                },
+                "checksum": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-11-02T09:30:00Z"
+                },
+                "file_name": {
+                    "type": "string",
+                    "example": "teaser.mp4"
+                },
+                "mime_type": {
+                    "type": "string",
+                    "example": "video/mp4"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 1048576
+                },
+                "task_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "attachments.ListofAttachments": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/attachments.AttachmentDetails"
+                    }
+                }
+            }
+        },
+        "brands.BrandDetails": {
+            "type": "object",
+            "properties": {
+                "brand": {
+                    "type": "string"
+                },
+                "brand_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "brands.BrandPatchPayload": {
+            "type": "object",
+            "properties": {
+                "brand": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
+                }
+            }
+        },
+        "brands.BrandRequestPayload": {
+            "type": "object",
+            "required": [
+                "brand"
+            ],
+            "properties": {
+                "brand": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
+                }
+            }
+        },
+        "brands.ListofBrands": {
+            "type": "object",
+            "properties": {
+                "brands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/brands.BrandDetails"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/httpres.ListPagination"
+                }
+            }
+        },
+        "checklist.ChecklistItemDetails": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "boolean"
+                },
+                "completed_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:00Z"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Brand approval"
+                }
+            }
+        },
+        "checklist.ChecklistOrderPayload": {
+            "type": "object",
+            "required": [
+                "item_ids"
+            ],
+            "properties": {
+                "item_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "checklist.ChecklistPatchPayload": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "boolean"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "checklist.ChecklistRequestPayload": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "required": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Brand approval"
+                }
+            }
+        },
+        "checklist.ListofChecklistItems": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/checklist.ChecklistItemDetails"
+                    }
+                },
+                "progress": {
+                    "type": "integer",
+                    "example": 60
+                }
+            }
+        },
+        "comments.CommentDetails": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string",
+                    "example": "Dina"
+                },
+                "author_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "body": {
+                    "type": "string",
+                    "example": "Can we move the CTA to the first slide?"
+                },
+                "comment_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:00Z"
+                },
+                "deleted": {
+                    "type": "boolean"
+                },
+                "edited_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:45:00Z"
+                },
+                "parent_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "comments.CommentEditPayload": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 5000
+                }
+            }
+        },
+        "comments.CommentRequestPayload": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "Can we move the CTA to the first slide?"
+                },
+                "parent_id": {
+                    "description": "ParentID makes the comment a reply, replying to a reply continues the\nsame thread.",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "comments.CommentThreadDetails": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string",
+                    "example": "Dina"
+                },
+                "author_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "body": {
+                    "type": "string",
+                    "example": "Can we move the CTA to the first slide?"
+                },
+                "comment_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:00Z"
+                },
+                "deleted": {
+                    "type": "boolean"
+                },
+                "edited_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:45:00Z"
+                },
+                "parent_id": {
+                    "type": "integer"
+                },
+                "replies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/comments.CommentDetails"
+                    }
+                }
+            }
+        },
+        "comments.ListofComments": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/comments.CommentThreadDetails"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/httpres.ListPagination"
+                }
+            }
+        },
+        "httpres.BaseResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "httpres.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "httpres.ListPagination": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoidGFza19pZCIsInYiOlsiMjUiXX0"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "prev_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoidGFza19pZCIsInYiOlsiMSJdLCJiIjp0cnVlfQ"
+                },
+                "total_page": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "platforms.ListofPlatforms": {
+            "type": "object",
+            "properties": {
+                "meta": {
+                    "$ref": "#/definitions/httpres.ListPagination"
+                },
+                "platforms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/platforms.PlatformDetails"
+                    }
+                }
+            }
+        },
+        "platforms.PlatformDetails": {
+            "type": "object",
+            "properties": {
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "platforms.PlatformPatchPayload": {
+            "type": "object",
+            "properties": {
+                "platform": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
+                }
+            }
+        },
+        "platforms.PlatformRequestPayload": {
+            "type": "object",
+            "required": [
+                "platform"
+            ],
+            "properties": {
+                "platform": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
+                }
+            }
+        },
+        "reminders.DeliveryDetails": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-11-01T00:00:12Z"
+                },
+                "delivered_at": {
+                    "type": "string",
+                    "example": "2026-11-01T00:00:12Z"
+                },
+                "delivery_id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "last_status_code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "next_attempt_at": {
+                    "type": "string",
+                    "example": "2026-11-01T00:01:12Z"
+                },
+                "reminder_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "Pending",
+                        "Succeeded",
+                        "Failed"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://hooks.example.com/reminders"
+                }
+            }
+        },
+        "reminders.ListofDeliveries": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/reminders.DeliveryDetails"
+                    }
+                }
+            }
+        },
+        "reminders.ListofReminders": {
+            "type": "object",
+            "properties": {
+                "reminders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/reminders.ReminderDetails"
+                    }
+                }
+            }
+        },
+        "reminders.ReminderDetails": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:00Z"
+                },
+                "fired_at": {
+                    "type": "string",
+                    "example": "2026-11-01T00:00:12Z"
+                },
+                "offset": {
+                    "type": "string",
+                    "example": "24h0m0s"
+                },
+                "offset_minutes": {
+                    "type": "integer",
+                    "example": 1440
+                },
+                "remind_at": {
+                    "type": "string",
+                    "example": "2026-11-01T00:00:00Z"
+                },
+                "reminder_id": {
+                    "type": "integer"
+                },
+                "task_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "reminders.ReminderRequestPayload": {
+            "type": "object",
+            "required": [
+                "offset"
+            ],
+            "properties": {
+                "offset": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "example": "24h before"
+                }
+            }
+        },
+        "series.ListofSeries": {
+            "type": "object",
+            "properties": {
+                "meta": {
+                    "$ref": "#/definitions/httpres.ListPagination"
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/series.SeriesDetails"
+                    }
+                }
+            }
+        },
+        "series.SeriesDetails": {
+            "type": "object",
+            "properties": {
+                "brand": {
+                    "type": "string"
+                },
+                "brand_id": {
+                    "type": "integer"
+                },
+                "caption": {
+                    "type": "string"
+                },
+                "cta_url": {
+                    "type": "string"
+                },
+                "hashtags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "materialized_until": {
+                    "type": "string",
+                    "example": "2026-12-28"
+                },
+                "payment": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "integer"
+                },
+                "rrule": {
+                    "type": "string",
+                    "example": "FREQ=WEEKLY;BYDAY=MO,WE,FR"
+                },
+                "series_id": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2026-11-02"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "until_date": {
+                    "type": "string",
+                    "example": "2026-12-31"
+                }
+            }
+        },
+        "series.SeriesRequestPayload": {
+            "type": "object",
+            "required": [
+                "brand_id",
+                "payment",
+                "platform_id",
+                "rrule",
+                "start_date",
+                "title"
+            ],
+            "properties": {
+                "brand_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "caption": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "cta_url": {
+                    "type": "string",
+                    "maxLength": 2048
+                },
+                "hashtags": {
+                    "type": "array",
+                    "maxItems": 30,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "payment": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "platform_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "rrule": {
+                    "type": "string",
+                    "maxLength": 512,
+                    "example": "FREQ=WEEKLY;BYDAY=MO,WE,FR"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "tags.ListofTags": {
+            "type": "object",
+            "properties": {
+                "meta": {
+                    "$ref": "#/definitions/httpres.ListPagination"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tags.TagDetails"
+                    }
+                }
+            }
+        },
+        "tags.TagDetails": {
+            "type": "object",
+            "properties": {
+                "tag": {
+                    "type": "string",
+                    "example": "needs-legal"
+                },
+                "tag_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tags.TagPatchPayload": {
+            "type": "object",
+            "properties": {
+                "tag": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                }
+            }
+        },
+        "tags.TagRequestPayload": {
+            "type": "object",
+            "required": [
+                "tag"
+            ],
+            "properties": {
+                "tag": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "needs-legal"
+                }
+            }
+        },
+        "tasks.ListofTaskStatusHistory": {
+            "type": "object",
+            "properties": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.TaskStatusHistoryDetails"
+                    }
+                }
+            }
+        },
+        "tasks.ListofTasks": {
+            "type": "object",
+            "properties": {
+                "meta": {
+                    "$ref": "#/definitions/httpres.ListPagination"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.TaskDetails"
+                    }
+                }
+            }
+        },
+        "tasks.TaskBulkItemResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "cannot change status from Completed to Scheduled"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "task_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tasks.TaskBulkPayload": {
+            "type": "object",
+            "required": [
+                "operation",
+                "task_ids"
+            ],
+            "properties": {
+                "days": {
+                    "type": "integer",
+                    "maximum": 3650,
+                    "minimum": -3650,
+                    "example": 7
+                },
+                "operation": {
+                    "type": "string",
+                    "enum": [
+                        "set_status",
+                        "shift_due_date",
+                        "set_platform",
+                        "delete"
+                    ]
+                },
+                "platform_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "Pending",
+                        "Completed",
+                        "Scheduled"
+                    ]
+                },
+                "task_ids": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "tasks.TaskBulkResult": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "operation": {
+                    "type": "string",
+                    "example": "set_status"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.TaskBulkItemResult"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer",
+                    "example": 9
+                }
+            }
+        },
+        "tasks.TaskCalendar": {
+            "type": "object",
+            "properties": {
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.TaskCalendarBucket"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2026-11-01"
+                },
+                "granularity": {
+                    "type": "string",
+                    "example": "week"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2026-11-30"
+                }
+            }
+        },
+        "tasks.TaskCalendarBucket": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string",
+                    "example": "2026-11-08"
+                },
+                "start": {
+                    "type": "string",
+                    "example": "2026-11-02"
+                },
+                "status_counts": {
+                    "$ref": "#/definitions/tasks.TaskStatusCounts"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.TaskDetails"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_payment": {
+                    "type": "string",
+                    "example": "1500000.00"
+                }
+            }
+        },
+        "tasks.TaskDetails": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "brand": {
+                    "type": "string"
+                },
+                "brand_id": {
+                    "type": "integer"
+                },
+                "caption": {
+                    "type": "string"
+                },
+                "comment_count": {
+                    "description": "CommentCount counts the comments and replies that are not deleted.",
+                    "type": "integer",
+                    "example": 4
+                },
+                "created_by": {
+                    "description": "CreatedBy is the user who created the task, null for tasks made before\nthere were users or by a series.",
+                    "type": "integer",
+                    "example": 3
+                },
+                "cta_url": {
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "hashtags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "overdue": {
+                    "type": "boolean"
+                },
+                "payment": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "integer"
+                },
+                "platforms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.TaskPlatformDetails"
+                    }
+                },
+                "progress": {
+                    "description": "Progress is the percentage of checklist items done, null without a\nchecklist.",
+                    "type": "integer",
+                    "example": 60
+                },
+                "reviewer_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.TaskTagDetails"
+                    }
+                },
+                "task_id": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "tasks.TaskImportResult": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tasks.TaskImportRowError"
+                    }
+                },
+                "invalid_rows": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "task_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "total_rows": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "valid_rows": {
+                    "type": "integer",
+                    "example": 24
+                }
+            }
+        },
+        "tasks.TaskImportRowError": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "brand Acme does not exist"
+                    ]
+                },
+                "row": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "tasks.TaskPatchPayload": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "description": "AssigneeID and ReviewerID of 0 take the assignee or reviewer off the\ntask, null cannot be sent in a merge patch.",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 3
+                },
+                "brand_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "caption": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "cta_url": {
+                    "type": "string",
+                    "maxLength": 2048
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "hashtags": {
+                    "type": "array",
+                    "maxItems": 30,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "payment": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "platform_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "platform_ids": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "reviewer_id": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "Pending",
+                        "Completed",
+                        "Scheduled"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "minLength": 1
+                }
+            }
+        },
+        "tasks.TaskPlatformDetails": {
+            "type": "object",
+            "properties": {
+                "payment": {
+                    "type": "string",
+                    "example": "150000.00"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "integer"
+                },
+                "primary": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "tasks.TaskPlatformPayload": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "payment": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "Pending",
+                        "Completed",
+                        "Scheduled"
+                    ]
+                }
+            }
+        },
+        "tasks.TaskRequestPayload": {
+            "type": "object",
+            "required": [
+                "due_date",
+                "payment",
+                "status",
+                "title"
+            ],
+            "properties": {
+                "assignee_id": {
+                    "description": "AssigneeID and ReviewerID must be members of the workspace. Left out of\nan update they are kept, 0 takes them off the task.",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 3
+                },
+                "brand_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "caption": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "cta_url": {
+                    "type": "string",
+                    "maxLength": 2048
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "hashtags": {
+                    "type": "array",
+                    "maxItems": 30,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "payment": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "platform_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "platform_ids": {
+                    "description": "PlatformIDs cross-posts the task, platform_id is then its primary\nplatform and defaults to the first one.",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "reviewer_id": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "Pending",
+                        "Completed",
+                        "Scheduled"
+                    ]
+                },
+                "tags": {
+                    "description": "Tags replaces the tags of the task by tag ID, left out they are kept.",
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
         },
-        "httpres.ErrorResponse": {
+        "tasks.TaskStatusCounts": {
             "type": "object",
             "properties": {
-                "message": {
+                "Completed": {
+                    "type": "integer"
+                },
+                "Pending": {
+                    "type": "integer"
+                },
+                "Scheduled": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tasks.TaskStatusHistoryDetails": {
+            "type": "object",
+            "properties": {
+                "changed_at": {
+                    "type": "string",
+                    "example": "2026-11-02T09:30:00Z"
+                },
+                "from_status": {
+                    "type": "string",
+                    "example": "Scheduled"
+                },
+                "history_id": {
+                    "type": "integer"
+                },
+                "platform_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "to_status": {
+                    "type": "string",
+                    "example": "Completed"
+                }
+            }
+        },
+        "tasks.TaskTagDetails": {
+            "type": "object",
+            "properties": {
+                "tag": {
+                    "type": "string",
+                    "example": "needs-legal"
+                },
+                "tag_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "users.LoginPayload": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 254,
+                    "example": "dina@example.com"
+                },
+                "password": {
                     "type": "string"
                 }
             }
         },
-        "httpres.ListPagination": {
+        "users.RefreshPayload": {
             "type": "object",
+            "required": [
+                "refresh_token"
+            ],
             "properties": {
-                "limit": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "users.RegisterPayload": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 254,
+                    "example": "dina@example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Dina"
+                },
+                "password": {
+                    "description": "Password is hashed with bcrypt, which refuses passwords longer than 72\nbytes. The service checks the length in bytes, a validator max counts\ncharacters.",
+                    "type": "string",
+                    "minLength": 8
+                }
+            }
+        },
+        "users.TokenDetails": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "description": "ExpiresIn is the lifetime of the access token in seconds.",
                     "type": "integer",
-                    "example": 100
+                    "example": 900
                 },
-                "page": {
+                "refresh_token": {
+                    "type": "string"
+                },
+                "token_type": {
+                    "type": "string",
+                    "example": "Bearer"
+                }
+            }
+        },
+        "users.UserDetails": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "dina@example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Dina"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "webhooks.AttemptDetails": {
+            "type": "object",
+            "properties": {
+                "attempt": {
                     "type": "integer",
                     "example": 1
                 },
-                "total_page": {
+                "attempted_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:01Z"
+                },
+                "duration_ms": {
                     "type": "integer",
-                    "example": 10
+                    "example": 84
+                },
+                "error": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer",
+                    "example": 200
                 }
             }
         },
-        "platforms.ListofPlatforms": {
+        "webhooks.DeliveryDetails": {
             "type": "object",
             "properties": {
+                "attempts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/webhooks.AttemptDetails"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:00Z"
+                },
+                "delivered_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:01Z"
+                },
+                "delivery_id": {
+                    "type": "integer"
+                },
+                "event": {
+                    "type": "string",
+                    "example": "task.created"
+                },
+                "next_attempt_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:31:01Z"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "Pending",
+                        "Succeeded",
+                        "Failed"
+                    ]
+                }
+            }
+        },
+        "webhooks.ListofDeliveries": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/webhooks.DeliveryDetails"
+                    }
+                },
                 "meta": {
                     "$ref": "#/definitions/httpres.ListPagination"
-                },
-                "platforms": {
+                }
+            }
+        },
+        "webhooks.ListofWebhooks": {
+            "type": "object",
+            "properties": {
+                "webhooks": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/platforms.PlatformDetails"
+                        "$ref": "#/definitions/webhooks.WebhookDetails"
                     }
                 }
             }
         },
-        "platforms.PlatformDetails": {
+        "webhooks.WebhookDetails": {
             "type": "object",
             "properties": {
-                "platform": {
-                    "type": "string"
+                "active": {
+                    "type": "boolean"
                 },
-                "platform_id": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:00Z"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "task.created",
+                        "task.completed"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://hooks.example.com/sosmed"
+                },
+                "webhook_id": {
                     "type": "integer"
                 }
             }
         },
-        "platforms.PlatformRequestPayload": {
+        "webhooks.WebhookRequestPayload": {
             "type": "object",
             "required": [
-                "platform"
+                "events",
+                "secret",
+                "url"
             ],
             "properties": {
-                "platform": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "events": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "task.created",
+                        "task.completed"
+                    ]
+                },
+                "secret": {
                     "type": "string",
                     "maxLength": 200,
-                    "minLength": 1
+                    "minLength": 16,
+                    "example": "change-me-to-a-long-random-string"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://hooks.example.com/sosmed"
                 }
             }
         },
-        "tasks.ListofTasks": {
+        "workspaces.ListofMembers": {
             "type": "object",
             "properties": {
-                "meta": {
-                    "$ref": "#/definitions/httpres.ListPagination"
-                },
-                "tasks": {
+                "members": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/tasks.TaskDetails"
+                        "$ref": "#/definitions/workspaces.MemberDetails"
                     }
                 }
             }
         },
-        "tasks.TaskDetails": {
+        "workspaces.ListofWorkspaces": {
             "type": "object",
             "properties": {
-                "brand": {
-                    "type": "string"
-                },
-                "brand_id": {
-                    "type": "integer"
-                },
-                "due_date": {
-                    "type": "string"
-                },
-                "payment": {
-                    "type": "string"
+                "workspaces": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/workspaces.WorkspaceDetails"
+                    }
+                }
+            }
+        },
+        "workspaces.MemberDetails": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "raka@example.com"
                 },
-                "platform": {
-                    "type": "string"
+                "joined_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:30:00Z"
                 },
-                "platform_id": {
-                    "type": "integer"
+                "name": {
+                    "type": "string",
+                    "example": "Raka"
                 },
-                "status": {
-                    "type": "string"
+                "role": {
+                    "type": "string",
+                    "example": "creator"
                 },
-                "task_id": {
+                "user_id": {
                     "type": "integer"
-                },
-                "title": {
-                    "type": "string"
                 }
             }
         },
-        "tasks.TaskRequestPayload": {
+        "workspaces.MemberRequestPayload": {
             "type": "object",
             "required": [
-                "due_date",
-                "payment",
-                "status",
-                "title"
+                "email",
+                "role"
             ],
             "properties": {
-                "brand_id": {
-                    "type": "integer",
-                    "minimum": 1
-                },
-                "due_date": {
-                    "type": "string"
+                "email": {
+                    "description": "Email is the address of the account to add, it must be registered.",
+                    "type": "string",
+                    "maxLength": 254,
+                    "example": "raka@example.com"
                 },
-                "payment": {
-                    "type": "integer"
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "manager",
+                        "creator",
+                        "viewer"
+                    ],
+                    "example": "creator"
+                }
+            }
+        },
+        "workspaces.RolePayload": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "manager",
+                        "creator",
+                        "viewer"
+                    ],
+                    "example": "manager"
+                }
+            }
+        },
+        "workspaces.WorkspaceDetails": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-10-18T09:00:00Z"
                 },
-                "platform_id": {
-                    "type": "integer",
-                    "minimum": 1
+                "name": {
+                    "type": "string",
+                    "example": "Dina's team"
                 },
-                "status": {
+                "role": {
+                    "description": "Role is the role of the caller in the workspace.",
                     "type": "string",
-                    "enum": [
-                        "Pending",
-                        "Completed",
-                        "Scheduled"
-                    ]
+                    "example": "owner"
                 },
-                "title": {
-                    "type": "string"
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "workspaces.WorkspaceRequestPayload": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Dina's team"
                 }
             }
         }
-    }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "Access token from /auth/login, sent as \"Bearer \u003ctoken\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
+    },
+    "security": [
+        {
+            "BearerAuth": []
+        }
+    ]
 }`
 
 // SwaggerInfo holds exported Swagger Info so clients can modify it
@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// Kinds of tokens. An access token authenticates requests, a refresh token
+// can only be traded for a new pair of tokens.
+const (
+	AccessToken  = "access"
+	RefreshToken = "refresh"
+)
+
+// Scopes of an API key. A read scope allows GET requests to the routes of a
+// resource, a write scope every other method.
+const (
+	ScopeTasksRead      = "tasks:read"
+	ScopeTasksWrite     = "tasks:write"
+	ScopeBrandsRead     = "brands:read"
+	ScopeBrandsWrite    = "brands:write"
+	ScopePlatformsRead  = "platforms:read"
+	ScopePlatformsWrite = "platforms:write"
+	ScopeTagsRead       = "tags:read"
+	ScopeTagsWrite      = "tags:write"
+	ScopeWebhooksRead   = "webhooks:read"
+	ScopeWebhooksWrite  = "webhooks:write"
+)
+
+var Scopes = []string{
+	ScopeTasksRead, ScopeTasksWrite,
+	ScopeBrandsRead, ScopeBrandsWrite,
+	ScopePlatformsRead, ScopePlatformsWrite,
+	ScopeTagsRead, ScopeTagsWrite,
+	ScopeWebhooksRead, ScopeWebhooksWrite,
+}
+
+// APIKeyPrefix starts every API key, telling keys apart from JWTs in the
+// Authorization header.
+const APIKeyPrefix = "stk_"
+
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// HashAPIKey returns the hash an API key is stored and looked up by. Keys are
+// long random strings, so a fast hash is enough.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+type Claims struct {
+	jwt.RegisteredClaims
+	Kind string `json:"typ"`
+}
+
+// UserID returns the ID of the user the token was issued to.
+func (c *Claims) UserID() (int64, error) {
+	return strconv.ParseInt(c.Subject, 10, 64)
+}
+
+// Tokens issues and verifies HS256 signed JWTs.
+type Tokens struct {
+	secret     []byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+func NewTokens(secret string, accessTTL time.Duration, refreshTTL time.Duration) *Tokens {
+	return &Tokens{
+		secret:     []byte(secret),
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}
+}
+
+// Issue signs a token of the given kind for a user. Every token gets a unique
+// ID so a refresh token can be revoked on its own.
+func (t *Tokens) Issue(kind string, userID int64) (string, *Claims, error) {
+	ttl := t.accessTTL
+	if kind == RefreshToken {
+		ttl = t.refreshTTL
+	}
+
+	now := time.Now()
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   strconv.FormatInt(userID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Kind: kind,
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(t.secret)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, claims, nil
+}
+
+// Verify checks the signature and expiry of a token and that it is of the
+// expected kind, so a refresh token is never accepted as an access token.
+func (t *Tokens) Verify(token string, kind string) (*Claims, error) {
+	claims := &Claims{}
+
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return t.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if claims.Kind != kind {
+		return nil, fmt.Errorf("%w: expected an %s token", ErrInvalidToken, kind)
+	}
+
+	if _, err = claims.UserID(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	return claims, nil
+}
+
+// User is the authenticated caller of a request, working in WorkspaceID with
+// the role they have there. WorkspaceID is 0 for a user who is not a member of
+// any workspace. KeyID is set when the caller used an API key, which then
+// limits the request to its scopes on top of the role of its owner.
+type User struct {
+	ID          int64
+	WorkspaceID int64
+	Role        string
+	KeyID       int64
+	Scopes      []string
+}
+
+// HasScope reports whether the caller may use scope. A user logged in with a
+// password is not limited by scopes.
+func (u *User) HasScope(scope string) bool {
+	return u.KeyID == 0 || slices.Contains(u.Scopes, scope)
+}
+
+type userKey struct{}
+
+func NewContext(ctx context.Context, user *User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// FromContext returns the authenticated caller, ok is false outside of an
+// authenticated request such as in background jobs.
+func FromContext(ctx context.Context) (user *User, ok bool) {
+	user, ok = ctx.Value(userKey{}).(*User)
+	return user, ok
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+)
+
+// Roles of a member of a workspace, from the most to the least trusted.
+const (
+	RoleOwner   = "owner"
+	RoleManager = "manager"
+	RoleCreator = "creator"
+	RoleViewer  = "viewer"
+)
+
+var Roles = []string{RoleOwner, RoleManager, RoleCreator, RoleViewer}
+
+// Permission is an action a role may take. Reading is not a permission,
+// every role may read.
+type Permission string
+
+const (
+	// PermManageCatalog covers creating, changing and deleting brands,
+	// platforms and tags.
+	PermManageCatalog Permission = "catalog:manage"
+	PermCreateTasks   Permission = "tasks:create"
+	// PermEditOwnTasks allows changing a task, its status included, only when
+	// the caller created it or is assigned to it.
+	PermEditOwnTasks Permission = "tasks:edit_own"
+	PermEditTasks    Permission = "tasks:edit"
+	PermDeleteTasks  Permission = "tasks:delete"
+	// PermManageWebhooks covers creating, changing and deleting the webhooks
+	// of the workspace.
+	PermManageWebhooks Permission = "webhooks:manage"
+	// PermViewMembers and PermManageMembers cover the members of the
+	// workspace and their roles.
+	PermViewMembers   Permission = "members:view"
+	PermManageMembers Permission = "members:manage"
+)
+
+var rolePermissions = map[string][]Permission{
+	RoleOwner: {
+		PermManageCatalog, PermCreateTasks, PermEditOwnTasks, PermEditTasks, PermDeleteTasks,
+		PermManageWebhooks, PermViewMembers, PermManageMembers,
+	},
+	RoleManager: {
+		PermManageCatalog, PermCreateTasks, PermEditOwnTasks, PermEditTasks, PermDeleteTasks,
+		PermManageWebhooks, PermViewMembers,
+	},
+	RoleCreator: {PermCreateTasks, PermEditOwnTasks},
+	RoleViewer:  {},
+}
+
+// Can reports whether the role of the caller grants perm.
+func (u *User) Can(perm Permission) bool {
+	return slices.Contains(rolePermissions[u.Role], perm)
+}
+
+// CanEditTask reports whether the caller may change a task created by
+// createdBy and assigned to assigneeID.
+func (u *User) CanEditTask(createdBy int64, assigneeID int64) bool {
+	if u.Can(PermEditTasks) {
+		return true
+	}
+
+	return u.Can(PermEditOwnTasks) && (createdBy == u.ID || assigneeID == u.ID)
+}
+
+// Caller returns the authenticated caller of a request.
+func Caller(ctx context.Context) (*User, error) {
+	user, ok := FromContext(ctx)
+	if !ok {
+		return nil, exceptions.NewUnauthorizedError("missing bearer token")
+	}
+
+	return user, nil
+}
+
+// Member returns the caller when they work in a workspace. Brands, platforms,
+// tasks and everything else belong to a workspace, so every service reading
+// or changing them starts here.
+func Member(ctx context.Context) (*User, error) {
+	user, err := Caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.WorkspaceID == 0 {
+		return user, exceptions.NewForbiddenError("you are not a member of any workspace, create one first")
+	}
+
+	return user, nil
+}
+
+// Authorize returns the caller of a request when their role in the workspace
+// grants perm and a forbidden error when it does not.
+func Authorize(ctx context.Context, perm Permission) (*User, error) {
+	user, err := Member(ctx)
+	if err != nil {
+		return user, err
+	}
+
+	if !user.Can(perm) {
+		return user, exceptions.NewForbiddenError("the " + user.Role + " role is not allowed to do this")
+	}
+
+	return user, nil
+}
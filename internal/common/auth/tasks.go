@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+)
+
+// NotTaskOwnerMessage tells a creator why they may not change a task.
+const NotTaskOwnerMessage = "you can only change tasks you created or are assigned to"
+
+// TaskOwners finds a task of a workspace and returns the users who created it
+// and are assigned to it, 0 when there is none. A task missing from the
+// workspace is a not found error.
+type TaskOwners func(ctx context.Context, workspaceID int64, taskID string) (createdBy int64, assigneeID int64, err error)
+
+// ReadTask returns the caller when the task is in their workspace. The
+// checklist, comments, attachments and reminders of a task are read through
+// it.
+func ReadTask(ctx context.Context, owners TaskOwners, taskID string) (*User, error) {
+	caller, err := Member(ctx)
+	if err != nil {
+		return caller, err
+	}
+
+	if _, _, err = owners(ctx, caller.WorkspaceID, taskID); err != nil {
+		return caller, err
+	}
+
+	return caller, nil
+}
+
+// EditTask returns the caller when they may change the task, and with it its
+// checklist, comments, attachments and reminders.
+func EditTask(ctx context.Context, owners TaskOwners, taskID string) (*User, error) {
+	caller, err := Authorize(ctx, PermEditOwnTasks)
+	if err != nil {
+		return caller, err
+	}
+
+	createdBy, assigneeID, err := owners(ctx, caller.WorkspaceID, taskID)
+	if err != nil {
+		return caller, err
+	}
+
+	if !caller.CanEditTask(createdBy, assigneeID) {
+		return caller, exceptions.NewForbiddenError(NotTaskOwnerMessage)
+	}
+
+	return caller, nil
+}
@@ -0,0 +1,32 @@
+package events
+
+import "context"
+
+const (
+	TaskCreated   = "task.created"
+	TaskUpdated   = "task.updated"
+	TaskCompleted = "task.completed"
+	TaskDeleted   = "task.deleted"
+
+	BrandCreated = "brand.created"
+	BrandUpdated = "brand.updated"
+	BrandDeleted = "brand.deleted"
+
+	PlatformCreated = "platform.created"
+	PlatformUpdated = "platform.updated"
+	PlatformDeleted = "platform.deleted"
+)
+
+// Types lists every event that can be subscribed to.
+var Types = []string{
+	TaskCreated, TaskUpdated, TaskCompleted, TaskDeleted,
+	BrandCreated, BrandUpdated, BrandDeleted,
+	PlatformCreated, PlatformUpdated, PlatformDeleted,
+}
+
+// Publisher hands the events of a domain to whoever listens to them. The
+// change behind an event has already been made when it is published, so a
+// publisher handles its own failures instead of returning them.
+type Publisher interface {
+	Publish(ctx context.Context, event string, data any)
+}
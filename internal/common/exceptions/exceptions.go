@@ -13,26 +13,26 @@ func CustomHTTPErrorHandler(logger zerolog.Logger) echo.HTTPErrorHandler {
 	return func(err error, c echo.Context) {
 		var report *echo.HTTPError
 
+		// a streamed body was already partly sent, all that is left is to log
+		if c.Response().Committed {
+			logger.Error().Err(err).Str("uri", c.Request().RequestURI).Msg("failed to finish streamed response")
+			return
+		}
+
 		if httpErr, ok := err.(*echo.HTTPError); ok {
 			report = httpErr
 		} else if invErr, ok := err.(InvariantError); ok {
 			report = echo.NewHTTPError(http.StatusBadRequest, invErr.Message)
 		} else if notFoundErr, ok := err.(NotFoundError); ok {
 			report = echo.NewHTTPError(http.StatusNotFound, notFoundErr.Message)
+		} else if unauthorizedErr, ok := err.(UnauthorizedError); ok {
+			c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+			report = echo.NewHTTPError(http.StatusUnauthorized, unauthorizedErr.Message)
+		} else if forbiddenErr, ok := err.(ForbiddenError); ok {
+			report = echo.NewHTTPError(http.StatusForbidden, forbiddenErr.Message)
 		} else if castedObject, ok := err.(validator.ValidationErrors); ok {
 			for _, fieldErr := range castedObject {
-				var message string
-				switch fieldErr.Tag() {
-				case "required":
-					message = fmt.Sprintf("%s is required", fieldErr.Field())
-				case "min":
-					message = fmt.Sprintf("%s value must be at least %s", fieldErr.Field(), fieldErr.Param())
-				case "max":
-					message = fmt.Sprintf("%s value must be at most %s", fieldErr.Field(), fieldErr.Param())
-				default:
-					message = fmt.Sprintf("%s is invalid", fieldErr.Field())
-				}
-				report = echo.NewHTTPError(http.StatusBadRequest, message)
+				report = echo.NewHTTPError(http.StatusBadRequest, ValidationMessage(fieldErr))
 			}
 		} else {
 			logger.Error().Err(err).Msg(err.Error())
@@ -52,3 +52,17 @@ func CustomHTTPErrorHandler(logger zerolog.Logger) echo.HTTPErrorHandler {
 		}
 	}
 }
+
+// ValidationMessage describes a failed validation rule of a field.
+func ValidationMessage(fieldErr validator.FieldError) string {
+	switch fieldErr.Tag() {
+	case "required", "required_if":
+		return fmt.Sprintf("%s is required", fieldErr.Field())
+	case "min":
+		return fmt.Sprintf("%s value must be at least %s", fieldErr.Field(), fieldErr.Param())
+	case "max":
+		return fmt.Sprintf("%s value must be at most %s", fieldErr.Field(), fieldErr.Param())
+	default:
+		return fmt.Sprintf("%s is invalid", fieldErr.Field())
+	}
+}
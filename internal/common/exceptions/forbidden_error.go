@@ -0,0 +1,13 @@
+package exceptions
+
+type ForbiddenError struct {
+	Message string
+}
+
+func (e ForbiddenError) Error() string {
+	return e.Message
+}
+
+func NewForbiddenError(msg string) ForbiddenError {
+	return ForbiddenError{Message: msg}
+}
@@ -0,0 +1,13 @@
+package exceptions
+
+type UnauthorizedError struct {
+	Message string
+}
+
+func (e UnauthorizedError) Error() string {
+	return e.Message
+}
+
+func NewUnauthorizedError(msg string) UnauthorizedError {
+	return UnauthorizedError{Message: msg}
+}
@@ -1,9 +1,14 @@
 package httpres
 
+// ListPagination is the meta of a list. A page fetched with a cursor has no
+// page number and skips counting the total, page and total_page are 0 then.
 type ListPagination struct {
-	Limit     uint64 `json:"limit" example:"100"`
-	Page      uint64 `json:"page" example:"1"`
-	TotalPage uint64 `json:"total_page" example:"10"`
+	Limit      uint64 `json:"limit" example:"100"`
+	Page       uint64 `json:"page" example:"1"`
+	TotalPage  uint64 `json:"total_page" example:"10"`
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJzIjoidGFza19pZCIsInYiOlsiMjUiXX0"`
+	PrevCursor string `json:"prev_cursor,omitempty" example:"eyJzIjoidGFza19pZCIsInYiOlsiMSJdLCJiIjp0cnVlfQ"`
+	HasMore    bool   `json:"has_more" example:"true"`
 }
 
 type BaseResponse struct {
@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// HeaderWorkspaceID picks the workspace a request made with an access token
+// works in. Without it the request works in the oldest workspace of the user.
+const HeaderWorkspaceID = "X-Workspace-ID"
+
+// UserAuthenticator resolves the user an access token was issued to, with
+// their current role in a workspace. The workspace ID is 0 when the request
+// did not pick one.
+type UserAuthenticator func(context.Context, int64, int64) (*auth.User, error)
+
+// KeyAuthenticator resolves an API key to its owner, the workspace the key
+// belongs to, the role of its owner there and the scopes of the key.
+type KeyAuthenticator func(context.Context, string) (*auth.User, error)
+
+// Authenticate rejects requests without a valid access token or API key in
+// the Authorization header and stores the caller in the request context,
+// where services find it with auth.FromContext. The user is looked up on
+// every request, so a changed role, a removed member or a deleted account
+// takes effect before the access token expires. Requests the skipper lets
+// through, such as logging in, are not checked.
+func Authenticate(tokens *auth.Tokens, users UserAuthenticator, keys KeyAuthenticator, skipper middleware.Skipper) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skipper(c) {
+				return next(c)
+			}
+
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			token, found := strings.CutPrefix(header, "Bearer ")
+			if !found || token == "" {
+				return exceptions.NewUnauthorizedError("missing bearer token")
+			}
+
+			var user *auth.User
+			var err error
+
+			if auth.IsAPIKey(token) {
+				if user, err = keys(c.Request().Context(), token); err != nil {
+					return err
+				}
+			} else {
+				claims, err := tokens.Verify(token, auth.AccessToken)
+				if err != nil {
+					return exceptions.NewUnauthorizedError("invalid or expired token")
+				}
+
+				var workspaceID int64
+				if header := c.Request().Header.Get(HeaderWorkspaceID); header != "" {
+					if workspaceID, err = strconv.ParseInt(header, 10, 64); err != nil || workspaceID <= 0 {
+						return exceptions.NewInvariantError(HeaderWorkspaceID + " must be a workspace ID")
+					}
+				}
+
+				userID, _ := claims.UserID()
+				if user, err = users(c.Request().Context(), userID, workspaceID); err != nil {
+					return err
+				}
+			}
+
+			ctx := auth.NewContext(c.Request().Context(), user)
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
+
+// RequireScopes limits API keys to the routes of a resource their scopes
+// cover, GET and HEAD requests need the read scope and the rest the write
+// scope. It is registered on the group of a controller.
+func RequireScopes(read string, write string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			user, ok := auth.FromContext(c.Request().Context())
+			if !ok {
+				return exceptions.NewUnauthorizedError("missing bearer token")
+			}
+
+			scope := write
+			if method := c.Request().Method; method == http.MethodGet || method == http.MethodHead {
+				scope = read
+			}
+
+			if !user.HasScope(scope) {
+				return exceptions.NewForbiddenError(fmt.Sprintf("api key is missing the %s scope", scope))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RequireSession keeps API keys out of routes only a logged in user may use,
+// so a key cannot be used to mint other keys.
+func RequireSession() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			user, ok := auth.FromContext(c.Request().Context())
+			if !ok {
+				return exceptions.NewUnauthorizedError("missing bearer token")
+			}
+
+			if user.KeyID != 0 {
+				return exceptions.NewForbiddenError("api keys cannot be used here, log in instead")
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxErrorBody caps how much of a failed response is kept for the delivery
+// log.
+const maxErrorBody = 1024
+
+// Client posts JSON payloads to webhook endpoints.
+type Client struct {
+	http *http.Client
+}
+
+func NewClient(timeout time.Duration) *Client {
+	return &Client{
+		http: &http.Client{Timeout: timeout},
+	}
+}
+
+// Post sends body to url and returns the response status code. Only a 2xx
+// response counts as delivered, any other status is returned with an error.
+func (c *Client) Post(ctx context.Context, url string, body []byte, header http.Header) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "sosmed-todolist-webhook/1.0")
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBody))
+		return res.StatusCode, fmt.Errorf("endpoint responded %s: %s", res.Status, bytes.TrimSpace(message))
+	}
+
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, maxErrorBody))
+
+	return res.StatusCode, nil
+}
+
+// Backoff is the delay before retrying after the given number of failed
+// attempts, doubling from base up to max.
+func Backoff(attempts int, base time.Duration, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+
+	if delay > max {
+		return max
+	}
+
+	return delay
+}
+
+// Sign is the hex encoded HMAC-SHA256 of "<timestamp>.<body>" keyed with
+// secret. Receivers recompute it to check that a request came from us and
+// reject old timestamps to stop replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
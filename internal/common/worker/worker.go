@@ -0,0 +1,32 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+type Job func(context.Context) error
+
+// Every runs job once right away and then on every interval until ctx is
+// cancelled. A failing run is logged and retried on the next tick.
+func Every(ctx context.Context, logger *zerolog.Logger, name string, interval time.Duration, job Job) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	logger.Info().Msgf("starting %s, running every %s", name, interval)
+
+	for {
+		if err := job(ctx); err != nil && ctx.Err() == nil {
+			logger.Error().Err(err).Msgf("%s run failed", name)
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info().Msgf("%s stopped", name)
+			return
+		case <-ticker.C:
+		}
+	}
+}
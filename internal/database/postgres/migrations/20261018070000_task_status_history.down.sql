@@ -0,0 +1 @@
+DROP TABLE task_status_history;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE task_status_history (
+    history_id SERIAL PRIMARY KEY,
+    task_id INT NOT NULL REFERENCES tasks(task_id) ON DELETE CASCADE,
+    from_status task_status DEFAULT NULL,
+    to_status task_status NOT NULL,
+    changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX task_status_history_task_id_idx ON task_status_history(task_id, changed_at);
+
+INSERT INTO task_status_history (task_id, from_status, to_status, changed_at)
+SELECT task_id, NULL, status, COALESCE(created_at, CURRENT_TIMESTAMP) FROM tasks;
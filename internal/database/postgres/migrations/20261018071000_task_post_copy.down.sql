@@ -0,0 +1,6 @@
+DROP INDEX tasks_hashtags_idx;
+
+ALTER TABLE tasks
+    DROP COLUMN caption,
+    DROP COLUMN hashtags,
+    DROP COLUMN cta_url;
@@ -0,0 +1,6 @@
+ALTER TABLE tasks
+    ADD COLUMN caption TEXT NOT NULL DEFAULT '',
+    ADD COLUMN hashtags TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN cta_url VARCHAR(2048) NOT NULL DEFAULT '';
+
+CREATE INDEX tasks_hashtags_idx ON tasks USING GIN (hashtags);
@@ -0,0 +1,14 @@
+CREATE TABLE task_attachments (
+    attachment_id SERIAL PRIMARY KEY,
+    task_id INT NOT NULL REFERENCES tasks(task_id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    mime_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE INDEX task_attachments_task_id_idx ON task_attachments(task_id);
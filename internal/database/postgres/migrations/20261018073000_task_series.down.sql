@@ -0,0 +1,7 @@
+DROP INDEX tasks_series_occurrence_idx;
+
+ALTER TABLE tasks
+    DROP COLUMN series_id,
+    DROP COLUMN occurrence_date;
+
+DROP TABLE task_series;
@@ -0,0 +1,25 @@
+CREATE TABLE task_series (
+    series_id SERIAL PRIMARY KEY,
+    title VARCHAR(255),
+    brand_id INT REFERENCES brands(brand_id) ON DELETE CASCADE,
+    platform_id INT REFERENCES platforms(platform_id) ON DELETE CASCADE,
+    payment DECIMAL(10,2) DEFAULT 0.00,
+    caption TEXT NOT NULL DEFAULT '',
+    hashtags TEXT[] NOT NULL DEFAULT '{}',
+    cta_url VARCHAR(2048) NOT NULL DEFAULT '',
+    rrule VARCHAR(512) NOT NULL,
+    start_date DATE NOT NULL,
+    until_date DATE DEFAULT NULL,
+    materialized_until DATE DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+ALTER TABLE tasks
+    ADD COLUMN series_id INT REFERENCES task_series(series_id) ON DELETE SET NULL,
+    ADD COLUMN occurrence_date DATE DEFAULT NULL;
+
+-- soft deleted rows are kept on purpose, a cancelled occurrence must not be
+-- materialised again
+CREATE UNIQUE INDEX tasks_series_occurrence_idx ON tasks(series_id, occurrence_date);
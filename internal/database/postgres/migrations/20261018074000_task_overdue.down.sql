@@ -0,0 +1,3 @@
+DROP INDEX tasks_open_due_date_idx;
+
+ALTER TABLE tasks DROP COLUMN overdue;
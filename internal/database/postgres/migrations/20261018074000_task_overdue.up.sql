@@ -0,0 +1,7 @@
+ALTER TABLE tasks ADD COLUMN overdue BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- the sweeper only looks at open tasks
+CREATE INDEX tasks_open_due_date_idx ON tasks(due_date) WHERE deleted_at IS NULL AND status <> 'Completed';
+
+UPDATE tasks SET overdue = TRUE
+WHERE deleted_at IS NULL AND status <> 'Completed' AND due_date < CURRENT_DATE;
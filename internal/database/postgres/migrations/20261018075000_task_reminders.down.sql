@@ -0,0 +1,5 @@
+DROP TABLE reminder_deliveries;
+
+DROP TYPE delivery_status;
+
+DROP TABLE task_reminders;
@@ -0,0 +1,33 @@
+CREATE TABLE task_reminders (
+    reminder_id SERIAL PRIMARY KEY,
+    task_id INT NOT NULL REFERENCES tasks(task_id) ON DELETE CASCADE,
+    offset_minutes INT NOT NULL CHECK (offset_minutes > 0),
+    -- the due date the reminder last fired for, moving the due date re-arms it
+    fired_due_date TIMESTAMP DEFAULT NULL,
+    fired_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE UNIQUE INDEX task_reminders_task_offset_idx ON task_reminders(task_id, offset_minutes) WHERE deleted_at IS NULL;
+
+CREATE TYPE delivery_status AS ENUM('Pending', 'Succeeded', 'Failed');
+
+CREATE TABLE reminder_deliveries (
+    delivery_id SERIAL PRIMARY KEY,
+    reminder_id INT NOT NULL REFERENCES task_reminders(reminder_id) ON DELETE CASCADE,
+    url VARCHAR(2048) NOT NULL,
+    payload JSONB NOT NULL,
+    status delivery_status NOT NULL DEFAULT 'Pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    last_status_code INT DEFAULT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX reminder_deliveries_reminder_id_idx ON reminder_deliveries(reminder_id);
+CREATE INDEX reminder_deliveries_pending_idx ON reminder_deliveries(next_attempt_at) WHERE status = 'Pending';
@@ -0,0 +1,5 @@
+DROP TRIGGER tasks_primary_platform ON tasks;
+
+DROP FUNCTION sync_task_primary_platform;
+
+DROP TABLE task_platforms;
@@ -0,0 +1,39 @@
+CREATE TABLE task_platforms (
+    task_id INT NOT NULL REFERENCES tasks(task_id) ON DELETE CASCADE,
+    platform_id INT NOT NULL REFERENCES platforms(platform_id) ON DELETE CASCADE,
+    status task_status NOT NULL DEFAULT 'Pending',
+    -- the part of the task payment for this platform, if it is split
+    payment DECIMAL(10,2) DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (task_id, platform_id)
+);
+
+CREATE INDEX task_platforms_platform_id_idx ON task_platforms(platform_id);
+
+-- tasks.platform_id stays the primary platform of a task and is always one of
+-- its platforms, whichever code path writes it. Moving the primary platform
+-- moves its row unless the new platform is already there.
+CREATE FUNCTION sync_task_primary_platform() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND NEW.platform_id IS DISTINCT FROM OLD.platform_id
+        AND NOT EXISTS (SELECT 1 FROM task_platforms WHERE task_id = NEW.task_id AND platform_id = NEW.platform_id) THEN
+        UPDATE task_platforms SET platform_id = NEW.platform_id, updated_at = NOW()
+        WHERE task_id = NEW.task_id AND platform_id = OLD.platform_id;
+    END IF;
+
+    IF NEW.platform_id IS NOT NULL THEN
+        INSERT INTO task_platforms (task_id, platform_id, status)
+        VALUES (NEW.task_id, NEW.platform_id, NEW.status)
+        ON CONFLICT (task_id, platform_id) DO NOTHING;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tasks_primary_platform AFTER INSERT OR UPDATE OF platform_id ON tasks
+FOR EACH ROW EXECUTE FUNCTION sync_task_primary_platform();
+
+INSERT INTO task_platforms (task_id, platform_id, status)
+SELECT task_id, platform_id, status FROM tasks WHERE platform_id IS NOT NULL;
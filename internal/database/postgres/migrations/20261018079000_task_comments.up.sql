@@ -0,0 +1,15 @@
+CREATE TABLE task_comments (
+    comment_id SERIAL PRIMARY KEY,
+    task_id INT NOT NULL REFERENCES tasks(task_id) ON DELETE CASCADE,
+    -- threads are one level deep, a reply always points at the first comment
+    parent_id INT DEFAULT NULL REFERENCES task_comments(comment_id) ON DELETE CASCADE,
+    author VARCHAR(100) NOT NULL,
+    body TEXT NOT NULL,
+    edited_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE INDEX task_comments_task_id_idx ON task_comments(task_id, comment_id) WHERE parent_id IS NULL;
+CREATE INDEX task_comments_parent_id_idx ON task_comments(parent_id, comment_id);
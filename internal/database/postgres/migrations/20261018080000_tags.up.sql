@@ -0,0 +1,19 @@
+CREATE TABLE tags (
+    tag_id SERIAL PRIMARY KEY,
+    tag VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+-- tags are matched case-insensitively, so "UGC" and "ugc" are the same tag
+CREATE UNIQUE INDEX tags_tag_key ON tags(lower(tag)) WHERE deleted_at IS NULL;
+
+CREATE TABLE task_tags (
+    task_id INT NOT NULL REFERENCES tasks(task_id) ON DELETE CASCADE,
+    tag_id INT NOT NULL REFERENCES tags(tag_id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (task_id, tag_id)
+);
+
+CREATE INDEX task_tags_tag_id_idx ON task_tags(tag_id);
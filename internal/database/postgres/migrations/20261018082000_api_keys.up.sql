@@ -0,0 +1,14 @@
+CREATE TABLE api_keys (
+    key_id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    -- the start of the key, shown so a key can be recognised without storing it
+    prefix VARCHAR(16) NOT NULL,
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    last_used_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE INDEX api_keys_user_id_idx ON api_keys(user_id) WHERE revoked_at IS NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE tasks DROP COLUMN created_by;
+
+ALTER TABLE users DROP COLUMN role;
+
+DROP TYPE user_role;
@@ -0,0 +1,11 @@
+CREATE TYPE user_role AS ENUM('owner', 'manager', 'creator', 'viewer');
+
+ALTER TABLE users ADD COLUMN role user_role NOT NULL DEFAULT 'viewer';
+
+-- accounts made before roles keep the access they had, the oldest one
+-- becomes the owner
+UPDATE users SET role = 'manager' WHERE deleted_at IS NULL;
+UPDATE users SET role = 'owner' WHERE user_id = (SELECT min(user_id) FROM users WHERE deleted_at IS NULL);
+
+ALTER TABLE tasks
+    ADD COLUMN created_by INT REFERENCES users(user_id) ON DELETE SET NULL;
@@ -0,0 +1,20 @@
+DROP INDEX tags_tag_key;
+CREATE UNIQUE INDEX tags_tag_key ON tags(lower(tag)) WHERE deleted_at IS NULL;
+
+ALTER TABLE api_keys DROP COLUMN workspace_id;
+ALTER TABLE webhooks DROP COLUMN workspace_id;
+ALTER TABLE task_series DROP COLUMN workspace_id;
+ALTER TABLE tasks DROP COLUMN workspace_id;
+ALTER TABLE tags DROP COLUMN workspace_id;
+ALTER TABLE platforms DROP COLUMN workspace_id;
+ALTER TABLE brands DROP COLUMN workspace_id;
+
+ALTER TABLE users ADD COLUMN role user_role NOT NULL DEFAULT 'viewer';
+
+UPDATE users u SET role = m.role
+FROM workspace_members m
+WHERE m.user_id = u.user_id AND m.workspace_id = (SELECT min(workspace_id) FROM workspaces);
+
+DROP TABLE workspace_members;
+
+DROP TABLE workspaces;
@@ -0,0 +1,65 @@
+CREATE TABLE workspaces (
+    workspace_id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+-- the role of a user now depends on the workspace they work in
+CREATE TABLE workspace_members (
+    workspace_id INT NOT NULL REFERENCES workspaces(workspace_id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    role user_role NOT NULL DEFAULT 'viewer',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (workspace_id, user_id)
+);
+
+CREATE INDEX workspace_members_user_id_idx ON workspace_members(user_id);
+
+-- everything made before workspaces moves into one workspace, with every user
+-- keeping their role in it
+INSERT INTO workspaces (name)
+SELECT 'Default'
+WHERE EXISTS (SELECT 1 FROM users) OR EXISTS (SELECT 1 FROM brands) OR EXISTS (SELECT 1 FROM platforms)
+    OR EXISTS (SELECT 1 FROM tags) OR EXISTS (SELECT 1 FROM tasks) OR EXISTS (SELECT 1 FROM task_series)
+    OR EXISTS (SELECT 1 FROM webhooks);
+
+INSERT INTO workspace_members (workspace_id, user_id, role)
+SELECT w.workspace_id, u.user_id, u.role FROM users u CROSS JOIN workspaces w;
+
+ALTER TABLE users DROP COLUMN role;
+
+ALTER TABLE brands ADD COLUMN workspace_id INT REFERENCES workspaces(workspace_id) ON DELETE CASCADE;
+ALTER TABLE platforms ADD COLUMN workspace_id INT REFERENCES workspaces(workspace_id) ON DELETE CASCADE;
+ALTER TABLE tags ADD COLUMN workspace_id INT REFERENCES workspaces(workspace_id) ON DELETE CASCADE;
+ALTER TABLE tasks ADD COLUMN workspace_id INT REFERENCES workspaces(workspace_id) ON DELETE CASCADE;
+ALTER TABLE task_series ADD COLUMN workspace_id INT REFERENCES workspaces(workspace_id) ON DELETE CASCADE;
+ALTER TABLE webhooks ADD COLUMN workspace_id INT REFERENCES workspaces(workspace_id) ON DELETE CASCADE;
+ALTER TABLE api_keys ADD COLUMN workspace_id INT REFERENCES workspaces(workspace_id) ON DELETE CASCADE;
+
+UPDATE brands SET workspace_id = (SELECT min(workspace_id) FROM workspaces);
+UPDATE platforms SET workspace_id = (SELECT min(workspace_id) FROM workspaces);
+UPDATE tags SET workspace_id = (SELECT min(workspace_id) FROM workspaces);
+UPDATE tasks SET workspace_id = (SELECT min(workspace_id) FROM workspaces);
+UPDATE task_series SET workspace_id = (SELECT min(workspace_id) FROM workspaces);
+UPDATE webhooks SET workspace_id = (SELECT min(workspace_id) FROM workspaces);
+UPDATE api_keys SET workspace_id = (SELECT min(workspace_id) FROM workspaces);
+
+ALTER TABLE brands ALTER COLUMN workspace_id SET NOT NULL;
+ALTER TABLE platforms ALTER COLUMN workspace_id SET NOT NULL;
+ALTER TABLE tags ALTER COLUMN workspace_id SET NOT NULL;
+ALTER TABLE tasks ALTER COLUMN workspace_id SET NOT NULL;
+ALTER TABLE task_series ALTER COLUMN workspace_id SET NOT NULL;
+ALTER TABLE webhooks ALTER COLUMN workspace_id SET NOT NULL;
+ALTER TABLE api_keys ALTER COLUMN workspace_id SET NOT NULL;
+
+CREATE INDEX brands_workspace_id_idx ON brands(workspace_id);
+CREATE INDEX platforms_workspace_id_idx ON platforms(workspace_id);
+CREATE INDEX tasks_workspace_id_idx ON tasks(workspace_id, due_date);
+CREATE INDEX task_series_workspace_id_idx ON task_series(workspace_id);
+CREATE INDEX webhooks_workspace_id_idx ON webhooks(workspace_id);
+
+-- tag names only have to be unique within a workspace
+DROP INDEX tags_tag_key;
+CREATE UNIQUE INDEX tags_tag_key ON tags(workspace_id, lower(tag)) WHERE deleted_at IS NULL;
@@ -0,0 +1,5 @@
+DROP INDEX tasks_assignee_id_idx;
+
+ALTER TABLE tasks
+    DROP COLUMN reviewer_id,
+    DROP COLUMN assignee_id;
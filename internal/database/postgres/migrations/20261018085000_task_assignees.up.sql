@@ -0,0 +1,6 @@
+ALTER TABLE tasks
+    ADD COLUMN assignee_id INT REFERENCES users(user_id) ON DELETE SET NULL,
+    ADD COLUMN reviewer_id INT REFERENCES users(user_id) ON DELETE SET NULL;
+
+-- serves GET /me/tasks and the assignee filter
+CREATE INDEX tasks_assignee_id_idx ON tasks(workspace_id, assignee_id, due_date) WHERE deleted_at IS NULL;
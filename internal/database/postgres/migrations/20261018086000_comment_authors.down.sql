@@ -0,0 +1,5 @@
+UPDATE task_comments c SET author = COALESCE((SELECT u.name FROM users u WHERE u.user_id = c.author_id), '') WHERE c.author IS NULL;
+
+ALTER TABLE task_comments
+    ALTER COLUMN author SET NOT NULL,
+    DROP COLUMN author_id;
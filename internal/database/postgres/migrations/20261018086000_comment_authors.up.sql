@@ -0,0 +1,5 @@
+-- author keeps the name typed in for comments written before their author was
+-- recorded
+ALTER TABLE task_comments
+    ADD COLUMN author_id INT REFERENCES users(user_id) ON DELETE SET NULL,
+    ALTER COLUMN author DROP NOT NULL;
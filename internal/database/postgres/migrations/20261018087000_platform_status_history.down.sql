@@ -0,0 +1,26 @@
+DROP TRIGGER tasks_primary_platform ON tasks;
+
+CREATE OR REPLACE FUNCTION sync_task_primary_platform() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND NEW.platform_id IS DISTINCT FROM OLD.platform_id
+        AND NOT EXISTS (SELECT 1 FROM task_platforms WHERE task_id = NEW.task_id AND platform_id = NEW.platform_id) THEN
+        UPDATE task_platforms SET platform_id = NEW.platform_id, updated_at = NOW()
+        WHERE task_id = NEW.task_id AND platform_id = OLD.platform_id;
+    END IF;
+
+    IF NEW.platform_id IS NOT NULL THEN
+        INSERT INTO task_platforms (task_id, platform_id, status)
+        VALUES (NEW.task_id, NEW.platform_id, NEW.status)
+        ON CONFLICT (task_id, platform_id) DO NOTHING;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tasks_primary_platform AFTER INSERT OR UPDATE OF platform_id ON tasks
+FOR EACH ROW EXECUTE FUNCTION sync_task_primary_platform();
+
+DELETE FROM task_status_history WHERE platform_id IS NOT NULL;
+
+ALTER TABLE task_status_history DROP COLUMN platform_id;
@@ -0,0 +1,34 @@
+-- the status history of a task on one of its platforms, the primary platform
+-- follows the task and its changes are recorded without a platform
+ALTER TABLE task_status_history
+    ADD COLUMN platform_id INT DEFAULT NULL REFERENCES platforms(platform_id) ON DELETE CASCADE;
+
+-- the row of the primary platform of a task also keeps the status of the
+-- task, whichever code path writes it
+CREATE OR REPLACE FUNCTION sync_task_primary_platform() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND NEW.platform_id IS DISTINCT FROM OLD.platform_id
+        AND NOT EXISTS (SELECT 1 FROM task_platforms WHERE task_id = NEW.task_id AND platform_id = NEW.platform_id) THEN
+        UPDATE task_platforms SET platform_id = NEW.platform_id, updated_at = NOW()
+        WHERE task_id = NEW.task_id AND platform_id = OLD.platform_id;
+    END IF;
+
+    IF NEW.platform_id IS NOT NULL THEN
+        INSERT INTO task_platforms (task_id, platform_id, status)
+        VALUES (NEW.task_id, NEW.platform_id, NEW.status)
+        ON CONFLICT (task_id, platform_id) DO UPDATE SET status = EXCLUDED.status, updated_at = NOW()
+        WHERE task_platforms.status IS DISTINCT FROM EXCLUDED.status;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER tasks_primary_platform ON tasks;
+
+CREATE TRIGGER tasks_primary_platform AFTER INSERT OR UPDATE OF platform_id, status ON tasks
+FOR EACH ROW EXECUTE FUNCTION sync_task_primary_platform();
+
+UPDATE task_platforms tp SET status = t.status, updated_at = NOW()
+FROM tasks t
+WHERE t.task_id = tp.task_id AND t.platform_id = tp.platform_id AND tp.status IS DISTINCT FROM t.status;
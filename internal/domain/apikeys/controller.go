@@ -0,0 +1,28 @@
+package apikeys
+
+import (
+	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type APIKeysController struct {
+	svc APIKeysService
+}
+
+func NewController(svc APIKeysService) *APIKeysController {
+	return &APIKeysController{
+		svc: svc,
+	}
+}
+
+const (
+	apiKeysBasepath = "/api-keys"
+)
+
+func (con *APIKeysController) Route(grp *echo.Group){
+	subrouter := grp.Group(apiKeysBasepath, middleware.RequireSession())
+
+	subrouter.GET("", HandleGetAllAPIKeys(con.svc.GetAll))
+	subrouter.POST("", HandleCreateAPIKeys(con.svc.Create))
+	subrouter.DELETE("/:key_id", HandleDeleteAPIKeys(con.svc.Delete))
+}
@@ -0,0 +1,31 @@
+package apikeys
+
+type APIKeyRequestParams struct {
+	KeyID string `param:"key_id" validate:"required"`
+}
+
+type APIKeyRequestPayload struct {
+	Name   string   `json:"name" validate:"required,max=100" example:"Publishing bot"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,required,max=50" example:"tasks:read,tasks:write"`
+}
+
+type APIKeyDetails struct {
+	KeyID int64 `json:"key_id"`
+	// WorkspaceID is the workspace the key works in.
+	WorkspaceID int64    `json:"workspace_id"`
+	Name        string   `json:"name" example:"Publishing bot"`
+	Prefix      string   `json:"prefix" example:"stk_Xk2mQ9aB"`
+	Scopes      []string `json:"scopes" example:"tasks:read,tasks:write"`
+	LastUsedAt  *string  `json:"last_used_at" example:"2026-10-18T09:30:00Z"`
+	CreatedAt   string   `json:"created_at" example:"2026-10-18T09:00:00Z"`
+}
+
+// CreatedAPIKeyDetails carries the key itself, which is only ever shown once.
+type CreatedAPIKeyDetails struct {
+	APIKeyDetails
+	Key string `json:"key" example:"stk_Xk2mQ9aBv1t0Yd8Qw3eRr5tTy7uUi9oOp1aAs3dDf5g"`
+}
+
+type ListofAPIKeys struct {
+	APIKeys []*APIKeyDetails `json:"api_keys"`
+}
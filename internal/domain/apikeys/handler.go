@@ -0,0 +1,107 @@
+package apikeys
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type GetAllAPIKeysHandler func(context.Context) (*ListofAPIKeys, error)
+type CreateAPIKeysHandler func(context.Context, *APIKeyRequestPayload) (*CreatedAPIKeyDetails, error)
+type DeleteAPIKeysHandler func(context.Context, *APIKeyRequestParams) error
+
+// Get All API Keys godoc
+//
+//	@Summary		Get the API keys of the logged in user
+//	@Description	Needs a login, API keys cannot manage keys.
+//	@Tags			API Key
+//	@Produce		json
+//	@Success		200	{object}	ListofAPIKeys			"Successfully fetched all api keys"
+//	@Failure		401	{object}	httpres.ErrorResponse	"Missing or invalid access token"
+//	@Failure		403	{object}	httpres.ErrorResponse	"Called with an API key"
+//	@Failure		500	{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/api-keys [get]
+func HandleGetAllAPIKeys(handler GetAllAPIKeysHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		data, err := handler(ctx)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "All api keys fetched successfully")
+	}
+}
+
+// Create API Key godoc
+//
+//	@Summary		Create an API key
+//	@Description	Scopes are tasks:read, tasks:write, brands:read, brands:write, platforms:read, platforms:write, tags:read, tags:write, webhooks:read and webhooks:write. The key is only returned in this response, send it as "Authorization: Bearer <key>".
+//	@Tags			API Key
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		APIKeyRequestPayload	true	"API key details"
+//	@Success		201		{object}	CreatedAPIKeyDetails	"API key successfully created"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		401		{object}	httpres.ErrorResponse	"Missing or invalid access token"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Called with an API key"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/api-keys [post]
+func HandleCreateAPIKeys(handler CreateAPIKeysHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		payload := &APIKeyRequestPayload{}
+
+		if err := c.Bind(payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, payload)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusCreated, data, "New api key successfully added")
+	}
+}
+
+// Revoke API Key godoc
+//
+//	@Summary	Revoke an API key
+//	@Tags		API Key
+//	@Produce	json
+//	@Param		key_id	path		string					true	"API Key ID"
+//	@Success	200		{object}	httpres.BaseResponse	"API key revoked successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	401		{object}	httpres.ErrorResponse	"Missing or invalid access token"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Called with an API key"
+//	@Failure	404		{object}	httpres.ErrorResponse	"API key not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/api-keys/{key_id} [delete]
+func HandleDeleteAPIKeys(handler DeleteAPIKeysHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &APIKeyRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Api key revoked successfully")
+	}
+}
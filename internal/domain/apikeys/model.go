@@ -0,0 +1,20 @@
+package apikeys
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+)
+
+type APIKeys struct {
+	KeyID       int64             `db:"key_id"`
+	UserID      int64             `db:"user_id"`
+	WorkspaceID int64             `db:"workspace_id"`
+	Role        string            `db:"role"`
+	Name        string            `db:"name"`
+	Prefix      string            `db:"prefix"`
+	Scopes      utils.StringArray `db:"scopes"`
+	LastUsedAt  sql.NullTime      `db:"last_used_at"`
+	CreatedAt   time.Time         `db:"created_at"`
+}
@@ -0,0 +1,146 @@
+package apikeys
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/jmoiron/sqlx"
+)
+
+var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+var apiKeysColumns = []string{"k.key_id", "k.user_id", "k.workspace_id", "k.name", "k.prefix", "k.scopes", "k.last_used_at", "k.created_at"}
+
+type APIKeysRepository interface {
+	GetAll(context.Context, int64) ([]*APIKeys, error)
+	GetByID(context.Context, int64, any) (*APIKeys, error)
+	GetByHash(context.Context, string) (*APIKeys, error)
+	Add(context.Context, int64, int64, *APIKeyRequestPayload, string, string) (int64, error)
+	Revoke(context.Context, int64, *APIKeyRequestParams) error
+	Touch(context.Context, int64) error
+}
+
+type apiKeysRepository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) APIKeysRepository {
+	return &apiKeysRepository{
+		db: db,
+	}
+}
+
+func (r *apiKeysRepository) GetAll(ctx context.Context, userID int64) (resp []*APIKeys, err error) {
+	stmt, args, _ := pgSquirell.Select(apiKeysColumns...).
+						From("api_keys k").
+						Where(squirrel.Eq{"k.user_id": userID, "k.revoked_at": nil}).
+						OrderBy("k.key_id ASC").
+						ToSql()
+
+	resp = []*APIKeys{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (r *apiKeysRepository) GetByID(ctx context.Context, userID int64, keyID any) (resp *APIKeys, err error) {
+	stmt, args, _ := pgSquirell.Select(apiKeysColumns...).
+						From("api_keys k").
+						Where(squirrel.Eq{"k.key_id": keyID, "k.user_id": userID, "k.revoked_at": nil}).
+						ToSql()
+
+	resp = &APIKeys{}
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).StructScan(resp)
+	if err != nil && err != sql.ErrNoRows {
+		return resp, err
+	} else if err == sql.ErrNoRows {
+		return resp, exceptions.NewNotFoundError("api keys not found")
+	}
+
+	return resp, nil
+}
+
+// GetByHash finds the key a request was made with and the role of its owner
+// in the workspace of the key. Keys of deleted users, of users who left the
+// workspace and of deleted workspaces are not found.
+func (r *apiKeysRepository) GetByHash(ctx context.Context, keyHash string) (resp *APIKeys, err error) {
+	stmt, args, _ := pgSquirell.Select(append(apiKeysColumns, "m.role")...).
+						From("api_keys k").
+						Join("users u on k.user_id=u.user_id").
+						Join("workspace_members m on k.workspace_id=m.workspace_id and k.user_id=m.user_id").
+						Join("workspaces w on k.workspace_id=w.workspace_id").
+						Where(squirrel.Eq{"k.key_hash": keyHash, "k.revoked_at": nil, "u.deleted_at": nil, "w.deleted_at": nil}).
+						ToSql()
+
+	resp = &APIKeys{}
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).StructScan(resp)
+	if err != nil && err != sql.ErrNoRows {
+		return resp, err
+	} else if err == sql.ErrNoRows {
+		return resp, exceptions.NewNotFoundError("api keys not found")
+	}
+
+	return resp, nil
+}
+
+func (r *apiKeysRepository) Add(ctx context.Context, userID int64, workspaceID int64, payload *APIKeyRequestPayload, prefix string, keyHash string) (keyID int64, err error) {
+	stmt, args, _ := pgSquirell.Insert("api_keys").
+						Columns("user_id", "workspace_id", "name", "prefix", "key_hash", "scopes").
+						Values(userID, workspaceID, payload.Name, prefix, keyHash, payload.Scopes).
+						Suffix("RETURNING key_id").
+						ToSql()
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&keyID)
+	if err != nil {
+		return 0, err
+	}
+
+	return keyID, nil
+}
+
+func (r *apiKeysRepository) Revoke(ctx context.Context, userID int64, params *APIKeyRequestParams) (err error) {
+	stmt, args, _ := pgSquirell.Update("api_keys").
+						Set("revoked_at", squirrel.Expr("NOW()")).
+						Where(squirrel.Eq{"key_id": params.KeyID, "user_id": userID, "revoked_at": nil}).
+						ToSql()
+
+	result, err := r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("api keys not found")
+	}
+
+	return nil
+}
+
+// Touch records that a key was used. The timestamp is only moved once a
+// minute, so a busy key does not write on every request.
+func (r *apiKeysRepository) Touch(ctx context.Context, keyID int64) (err error) {
+	stmt, args, _ := pgSquirell.Update("api_keys").
+						Set("last_used_at", squirrel.Expr("NOW()")).
+						Where(squirrel.And{
+							squirrel.Eq{"key_id": keyID},
+							squirrel.Or{squirrel.Eq{"last_used_at": nil}, squirrel.Expr("last_used_at < NOW() - INTERVAL '1 minute'")},
+						}).
+						ToSql()
+
+	_, err = r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+)
+
+const (
+	// keyBytes of randomness make up a key, base64 encoded after the prefix.
+	keyBytes = 32
+	// prefixLength is how much of a key is kept to recognise it by.
+	prefixLength = len(auth.APIKeyPrefix) + 8
+)
+
+type APIKeysService interface {
+	GetAll(context.Context) (*ListofAPIKeys, error)
+	Create(context.Context, *APIKeyRequestPayload) (*CreatedAPIKeyDetails, error)
+	Delete(context.Context, *APIKeyRequestParams) error
+	Authenticate(context.Context, string) (*auth.User, error)
+}
+
+type apiKeysService struct {
+	repo APIKeysRepository
+}
+
+func NewService(r APIKeysRepository) *apiKeysService {
+	return &apiKeysService{repo: r}
+}
+
+func (svc *apiKeysService) GetAll(ctx context.Context) (listOfKeys *ListofAPIKeys, err error) {
+	caller, err := callerOf(ctx)
+	if err != nil {
+		return listOfKeys, err
+	}
+
+	keys, err := svc.repo.GetAll(ctx, caller.ID)
+	if err != nil {
+		return listOfKeys, err
+	}
+
+	listOfKeys = &ListofAPIKeys{
+		APIKeys: []*APIKeyDetails{},
+	}
+
+	for _, key := range keys {
+		listOfKeys.APIKeys = append(listOfKeys.APIKeys, toAPIKeyDetails(key))
+	}
+
+	return listOfKeys, nil
+}
+
+// Create issues a key for the caller in the workspace they work in. Only its
+// hash is stored, the key itself is returned this once.
+func (svc *apiKeysService) Create(ctx context.Context, payload *APIKeyRequestPayload) (keyDetails *CreatedAPIKeyDetails, err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return keyDetails, err
+	}
+
+	for _, scope := range payload.Scopes {
+		if !slices.Contains(auth.Scopes, scope) {
+			return keyDetails, exceptions.NewInvariantError(fmt.Sprintf("%s is not a scope, use one of %s", scope, strings.Join(auth.Scopes, ", ")))
+		}
+	}
+	payload.Scopes = slices.Compact(slices.Sorted(slices.Values(payload.Scopes)))
+
+	secret := make([]byte, keyBytes)
+	if _, err = rand.Read(secret); err != nil {
+		return keyDetails, err
+	}
+
+	key := auth.APIKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	keyID, err := svc.repo.Add(ctx, caller.ID, caller.WorkspaceID, payload, key[:prefixLength], auth.HashAPIKey(key))
+	if err != nil {
+		return keyDetails, err
+	}
+
+	created, err := svc.repo.GetByID(ctx, caller.ID, keyID)
+	if err != nil {
+		return keyDetails, err
+	}
+
+	keyDetails = &CreatedAPIKeyDetails{
+		APIKeyDetails: *toAPIKeyDetails(created),
+		Key:           key,
+	}
+
+	return keyDetails, nil
+}
+
+func (svc *apiKeysService) Delete(ctx context.Context, params *APIKeyRequestParams) (err error) {
+	caller, err := callerOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Revoke(ctx, caller.ID, params)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Authenticate resolves the key of a request to its owner, the workspace of
+// the key, the role of its owner there and the scopes of the key, and records
+// that the key was used.
+func (svc *apiKeysService) Authenticate(ctx context.Context, key string) (user *auth.User, err error) {
+	apiKey, err := svc.repo.GetByHash(ctx, auth.HashAPIKey(key))
+	if err != nil && !errors.As(err, &exceptions.NotFoundError{}) {
+		return user, err
+	} else if err != nil {
+		return user, exceptions.NewUnauthorizedError("invalid or revoked api key")
+	}
+
+	if err = svc.repo.Touch(ctx, apiKey.KeyID); err != nil {
+		return user, err
+	}
+
+	user = &auth.User{
+		ID:          apiKey.UserID,
+		WorkspaceID: apiKey.WorkspaceID,
+		Role:        apiKey.Role,
+		KeyID:       apiKey.KeyID,
+		Scopes:      apiKey.Scopes,
+	}
+
+	return user, nil
+}
+
+func callerOf(ctx context.Context) (*auth.User, error) {
+	caller, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, exceptions.NewUnauthorizedError("missing bearer token")
+	}
+
+	return caller, nil
+}
+
+func toAPIKeyDetails(key *APIKeys) *APIKeyDetails {
+	keyDetails := &APIKeyDetails{
+		KeyID:       key.KeyID,
+		WorkspaceID: key.WorkspaceID,
+		Name:        key.Name,
+		Prefix:      key.Prefix,
+		Scopes:      key.Scopes,
+		CreatedAt:   key.CreatedAt.Format(time.RFC3339),
+	}
+	if key.LastUsedAt.Valid {
+		lastUsedAt := key.LastUsedAt.Time.Format(time.RFC3339)
+		keyDetails.LastUsedAt = &lastUsedAt
+	}
+
+	return keyDetails
+}
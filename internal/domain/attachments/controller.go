@@ -0,0 +1,36 @@
+package attachments
+
+import (
+	"fmt"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/labstack/echo/v4"
+	ecmiddleware "github.com/labstack/echo/v4/middleware"
+)
+
+type AttachmentsController struct {
+	svc AttachmentsService
+}
+
+func NewController(svc AttachmentsService) *AttachmentsController {
+	return &AttachmentsController{
+		svc: svc,
+	}
+}
+
+const (
+	attachmentsBasepath = "/tasks/:task_id/attachments"
+)
+
+func (con *AttachmentsController) Route(grp *echo.Group){
+	subrouter := grp.Group(attachmentsBasepath, middleware.RequireScopes(auth.ScopeTasksRead, auth.ScopeTasksWrite))
+
+	// leave room for the multipart envelope around the file itself
+	bodyLimit := ecmiddleware.BodyLimit(fmt.Sprintf("%dM", maxUploadSizeMB+1))
+
+	subrouter.GET("", HandleGetAllAttachments(con.svc.GetAll))
+	subrouter.GET("/:attachment_id", HandleDownloadAttachments(con.svc.Download))
+	subrouter.POST("", HandleUploadAttachments(con.svc.Upload), bodyLimit)
+	subrouter.DELETE("/:attachment_id", HandleDeleteAttachments(con.svc.Delete))
+}
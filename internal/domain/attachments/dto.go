@@ -0,0 +1,38 @@
+package attachments
+
+import (
+	"io"
+	"mime/multipart"
+)
+
+type AttachmentListParams struct {
+	TaskID string `param:"task_id" validate:"required"`
+}
+
+type AttachmentRequestParams struct {
+	TaskID       string `param:"task_id" validate:"required"`
+	AttachmentID string `param:"attachment_id" validate:"required"`
+}
+
+type AttachmentUploadPayload struct {
+	File *multipart.FileHeader `form:"file" validate:"required"`
+}
+
+type AttachmentDetails struct {
+	AttachmentID int64  `json:"attachment_id"`
+	TaskID       int64  `json:"task_id"`
+	FileName     string `json:"file_name" example:"teaser.mp4"`
+	MimeType     string `json:"mime_type" example:"video/mp4"`
+	Size         int64  `json:"size" example:"1048576"`
+	Checksum     string `json:"checksum" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	CreatedAt    string `json:"created_at" example:"2026-11-02T09:30:00Z"`
+}
+
+type AttachmentContent struct {
+	Details *AttachmentDetails
+	Content io.ReadCloser
+}
+
+type ListofAttachments struct {
+	Attachments []*AttachmentDetails `json:"attachments"`
+}
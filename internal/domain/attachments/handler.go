@@ -0,0 +1,167 @@
+package attachments
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type GetAllAttachmentsHandler func(context.Context, *AttachmentListParams) (*ListofAttachments, error)
+type UploadAttachmentsHandler func(context.Context, *AttachmentListParams, *AttachmentUploadPayload) (*AttachmentDetails, error)
+type DownloadAttachmentsHandler func(context.Context, *AttachmentRequestParams) (*AttachmentContent, error)
+type DeleteAttachmentsHandler func(context.Context, *AttachmentRequestParams) error
+
+// Get All Attachments godoc
+//
+//	@Summary	Get all attachments of a task
+//	@Tags		Attachment
+//	@Produce	json
+//	@Param		task_id	path		string	true	"Task ID"
+//	@Success	200		{object}	ListofAttachments	"Successfully fetched all attachments"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Task not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/attachments [get]
+func HandleGetAllAttachments(handler GetAllAttachmentsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &AttachmentListParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "All attachments fetched successfully")
+	}
+}
+
+// Upload Attachment godoc
+//
+//	@Summary	Upload an image or video to a task
+//	@Tags		Attachment
+//	@Accept		multipart/form-data
+//	@Produce	json
+//	@Param		task_id	path		string	true	"Task ID"
+//	@Param		file	formData	file	true	"Image or video file"
+//	@Success	201		{object}	AttachmentDetails	"Attachment successfully uploaded"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not change the task"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Task not found"
+//	@Failure	413		{object}	httpres.ErrorResponse	"File too large"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/attachments [post]
+func HandleUploadAttachments(handler UploadAttachmentsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &AttachmentListParams{}
+		payload := &AttachmentUploadPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, params, payload)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusCreated, data, "Attachment successfully uploaded")
+	}
+}
+
+// Download Attachment godoc
+//
+//	@Summary	Download the content of an attachment
+//	@Tags		Attachment
+//	@Produce	octet-stream
+//	@Param		task_id			path	string	true	"Task ID"
+//	@Param		attachment_id	path	string	true	"Attachment ID"
+//	@Success	200		{file}		file					"Attachment content"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Attachment not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/attachments/{attachment_id} [get]
+func HandleDownloadAttachments(handler DownloadAttachmentsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &AttachmentRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, params)
+		if err != nil {
+			return err
+		}
+		defer data.Content.Close()
+
+		c.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": data.Details.FileName}))
+		c.Response().Header().Set(echo.HeaderContentLength, strconv.FormatInt(data.Details.Size, 10))
+
+		return c.Stream(http.StatusOK, data.Details.MimeType, data.Content)
+	}
+}
+
+// Delete Attachment godoc
+//
+//	@Summary	Delete an attachment
+//	@Tags		Attachment
+//	@Produce	json
+//	@Param		task_id			path	string	true	"Task ID"
+//	@Param		attachment_id	path	string	true	"Attachment ID"
+//	@Success	200		{object}	httpres.BaseResponse	"Attachment deleted successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not change the task"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Attachment not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/attachments/{attachment_id} [delete]
+func HandleDeleteAttachments(handler DeleteAttachmentsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &AttachmentRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Attachment deleted successfully")
+	}
+}
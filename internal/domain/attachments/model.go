@@ -0,0 +1,14 @@
+package attachments
+
+import "time"
+
+type Attachments struct {
+	AttachmentID int64     `db:"attachment_id"`
+	TaskID       int64     `db:"task_id"`
+	FileName     string    `db:"file_name"`
+	StorageKey   string    `db:"storage_key"`
+	MimeType     string    `db:"mime_type"`
+	Size         int64     `db:"size"`
+	Checksum     string    `db:"checksum"`
+	CreatedAt    time.Time `db:"created_at"`
+}
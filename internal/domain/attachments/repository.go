@@ -0,0 +1,99 @@
+package attachments
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/jmoiron/sqlx"
+)
+
+var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+type AttachmentsRepository interface {
+	GetAll(context.Context, *AttachmentListParams) ([]*Attachments, error)
+	GetByID(context.Context, *AttachmentRequestParams) (*Attachments, error)
+	Add(context.Context, *Attachments) error
+	Delete(context.Context, *AttachmentRequestParams) error
+}
+
+type attachmentsRepository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) AttachmentsRepository {
+	return &attachmentsRepository{
+		db: db,
+	}
+}
+
+func (r *attachmentsRepository) GetAll(ctx context.Context, params *AttachmentListParams) (resp []*Attachments, err error) {
+	stmt, args, _ := pgSquirell.Select("attachment_id", "task_id", "file_name", "storage_key", "mime_type", "size", "checksum", "created_at").
+						From("task_attachments").
+						Where(squirrel.And{squirrel.Eq{"deleted_at": nil}, squirrel.Eq{"task_id": params.TaskID}}).
+						OrderBy("created_at ASC", "attachment_id ASC").
+						ToSql()
+
+	resp = []*Attachments{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (r *attachmentsRepository) GetByID(ctx context.Context, params *AttachmentRequestParams) (resp *Attachments, err error) {
+	stmt, args, _ := pgSquirell.Select("attachment_id", "task_id", "file_name", "storage_key", "mime_type", "size", "checksum", "created_at").
+						From("task_attachments").
+						Where(squirrel.And{squirrel.Eq{"deleted_at": nil}, squirrel.Eq{"task_id": params.TaskID}, squirrel.Eq{"attachment_id": params.AttachmentID}}).
+						ToSql()
+
+	resp = &Attachments{}
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).StructScan(resp)
+	if err != nil && err != sql.ErrNoRows {
+		return resp, err
+	} else if err == sql.ErrNoRows {
+		return nil, exceptions.NewNotFoundError("attachments not found")
+	}
+
+	return resp, nil
+}
+
+func (r *attachmentsRepository) Add(ctx context.Context, attachment *Attachments) (err error) {
+	stmt, args, _ := pgSquirell.Insert("task_attachments").
+						Columns("task_id", "file_name", "storage_key", "mime_type", "size", "checksum").
+						Values(attachment.TaskID, attachment.FileName, attachment.StorageKey, attachment.MimeType, attachment.Size, attachment.Checksum).
+						Suffix("RETURNING attachment_id, created_at").
+						ToSql()
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&attachment.AttachmentID, &attachment.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *attachmentsRepository) Delete(ctx context.Context, params *AttachmentRequestParams) error {
+	stmt, args, _ := pgSquirell.Update("task_attachments").SetMap(map[string]interface{}{
+		"deleted_at":squirrel.Expr("NOW()"),
+	}).Where(squirrel.And{squirrel.Eq{"deleted_at": nil}, squirrel.Eq{"task_id": params.TaskID}, squirrel.Eq{"attachment_id": params.AttachmentID}}).ToSql()
+
+	result, err := r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("attachments not found")
+	}
+
+	return nil
+}
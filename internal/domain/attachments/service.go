@@ -0,0 +1,175 @@
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/agungramananda/sosmed-todolist/internal/storage"
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
+)
+
+// maxUploadSizeMB caps a single attachment, drafts are images and short videos.
+const maxUploadSizeMB = 100
+
+type AttachmentsService interface {
+	GetAll(context.Context, *AttachmentListParams) (*ListofAttachments, error)
+	Upload(context.Context, *AttachmentListParams, *AttachmentUploadPayload) (*AttachmentDetails, error)
+	Download(context.Context, *AttachmentRequestParams) (*AttachmentContent, error)
+	Delete(context.Context, *AttachmentRequestParams) error
+}
+
+type attachmentsService struct {
+	repo       AttachmentsRepository
+	taskOwners auth.TaskOwners
+	storage    storage.Storage
+}
+
+func NewService(r AttachmentsRepository, taskOwners auth.TaskOwners, s storage.Storage) *attachmentsService {
+	return &attachmentsService{repo: r, taskOwners: taskOwners, storage: s}
+}
+
+func (svc *attachmentsService) GetAll(ctx context.Context, params *AttachmentListParams) (listOfAttachments *ListofAttachments, err error) {
+	if _, err = auth.ReadTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return listOfAttachments, err
+	}
+
+	attachments, err := svc.repo.GetAll(ctx, params)
+	if err != nil {
+		return listOfAttachments, err
+	}
+
+	listOfAttachments = &ListofAttachments{
+		Attachments: []*AttachmentDetails{},
+	}
+
+	for _, attachment := range attachments {
+		listOfAttachments.Attachments = append(listOfAttachments.Attachments, toAttachmentDetails(attachment))
+	}
+
+	return listOfAttachments, nil
+}
+
+func (svc *attachmentsService) Upload(ctx context.Context, params *AttachmentListParams, payload *AttachmentUploadPayload) (attachmentDetails *AttachmentDetails, err error) {
+	taskID, err := strconv.ParseInt(params.TaskID, 10, 64)
+	if err != nil {
+		return attachmentDetails, exceptions.NewInvariantError("task_id must be a number")
+	}
+
+	if _, err = auth.EditTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return attachmentDetails, err
+	}
+
+	if payload.File.Size > maxUploadSizeMB<<20 {
+		return attachmentDetails, exceptions.NewInvariantError(fmt.Sprintf("file must be at most %d MB", maxUploadSizeMB))
+	}
+
+	file, err := payload.File.Open()
+	if err != nil {
+		return attachmentDetails, err
+	}
+	defer file.Close()
+
+	// sniff the type from the content rather than trusting the client's header
+	head := make([]byte, 3072)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return attachmentDetails, err
+	}
+	head = head[:n]
+
+	mimeType := mimetype.Detect(head).String()
+	if !strings.HasPrefix(mimeType, "image/") && !strings.HasPrefix(mimeType, "video/") {
+		return attachmentDetails, exceptions.NewInvariantError(fmt.Sprintf("%s files are not allowed, only images and videos", mimeType))
+	}
+
+	hash := sha256.New()
+	content := io.TeeReader(io.MultiReader(bytes.NewReader(head), file), hash)
+
+	attachment := &Attachments{
+		TaskID:     taskID,
+		FileName:   filepath.Base(payload.File.Filename),
+		StorageKey: path.Join("tasks", params.TaskID, uuid.NewString()),
+		MimeType:   mimeType,
+		Size:       payload.File.Size,
+	}
+
+	if err = svc.storage.Put(ctx, attachment.StorageKey, content); err != nil {
+		return attachmentDetails, err
+	}
+
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	if err = svc.repo.Add(ctx, attachment); err != nil {
+		svc.storage.Delete(ctx, attachment.StorageKey)
+		return attachmentDetails, err
+	}
+
+	return toAttachmentDetails(attachment), nil
+}
+
+func (svc *attachmentsService) Download(ctx context.Context, params *AttachmentRequestParams) (attachmentContent *AttachmentContent, err error) {
+	if _, err = auth.ReadTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return attachmentContent, err
+	}
+
+	attachment, err := svc.repo.GetByID(ctx, params)
+	if err != nil {
+		return attachmentContent, err
+	}
+
+	content, err := svc.storage.Get(ctx, attachment.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return attachmentContent, exceptions.NewNotFoundError("attachment content not found")
+	} else if err != nil {
+		return attachmentContent, err
+	}
+
+	attachmentContent = &AttachmentContent{
+		Details: toAttachmentDetails(attachment),
+		Content: content,
+	}
+
+	return attachmentContent, nil
+}
+
+func (svc *attachmentsService) Delete(ctx context.Context, params *AttachmentRequestParams) (err error) {
+	if _, err = auth.EditTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return err
+	}
+
+	attachment, err := svc.repo.GetByID(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	if err = svc.repo.Delete(ctx, params); err != nil {
+		return err
+	}
+
+	return svc.storage.Delete(ctx, attachment.StorageKey)
+}
+
+func toAttachmentDetails(attachment *Attachments) *AttachmentDetails {
+	return &AttachmentDetails{
+		AttachmentID: attachment.AttachmentID,
+		TaskID:       attachment.TaskID,
+		FileName:     attachment.FileName,
+		MimeType:     attachment.MimeType,
+		Size:         attachment.Size,
+		Checksum:     attachment.Checksum,
+		CreatedAt:    attachment.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -1,6 +1,10 @@
 package brands
 
-import "github.com/labstack/echo/v4"
+import (
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/labstack/echo/v4"
+)
 
 type BrandsController struct {
 	svc BrandsService
@@ -17,11 +21,12 @@ const (
 )
 
 func (con *BrandsController) Route(grp *echo.Group){
-	subrouter := grp.Group(brandsBasepath)
+	subrouter := grp.Group(brandsBasepath, middleware.RequireScopes(auth.ScopeBrandsRead, auth.ScopeBrandsWrite))
 
 	subrouter.GET("", HandleGetAllBrands(con.svc.GetAll))
 	subrouter.GET("/:brand_id", HandleGetOneBrands(con.svc.GetOne))
 	subrouter.POST("",HandleCreateBrands(con.svc.Create))
 	subrouter.PUT("/:brand_id", HandleUpdateBrands(con.svc.Update))
+	subrouter.PATCH("/:brand_id", HandlePatchBrands(con.svc.Patch))
 	subrouter.DELETE("/:brand_id", HandleDeleteBrands(con.svc.Delete))
 }
\ No newline at end of file
@@ -11,8 +11,16 @@ type BrandRequestPayload struct {
 	Brand   string `json:"brand" validate:"required,max=200,min=1"`
 }
 
+// BrandPatchPayload is a JSON Merge Patch of a brand, only the fields present
+// in the request are validated and updated.
+type BrandPatchPayload struct {
+	Brand *string `json:"brand" validate:"omitnil,max=200,min=1"`
+}
+
 type BrandRequestQuery struct {
 	Keyword string `query:"keyword" validate:"omitempty,max=100"`
+	Sort    string `query:"sort" validate:"omitempty,max=100"`
+	Cursor  string `query:"cursor" validate:"omitempty,max=1000"`
 	Limit   uint64 `query:"limit" validate:"omitempty,min=1,max=100"`
 	Page    uint64 `query:"page" validate:"omitempty,min=1"`
 }
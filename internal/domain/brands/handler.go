@@ -12,6 +12,7 @@ type GetAllBrandsHandler func(context.Context, *BrandRequestQuery) (*ListofBrand
 type GetOneBrandsHandler func(context.Context, *BrandRequestParams) (*BrandDetails, error)
 type CreateBrandsHandler func(context.Context, *BrandRequestPayload) error
 type UpdateBrandsHandler func(context.Context, *BrandRequestParams, *BrandRequestPayload) error
+type PatchBrandsHandler func(context.Context, *BrandRequestParams, *BrandPatchPayload) error
 type DeleteBrandsHandler func(context.Context, *BrandRequestParams) error
 
 // Get All Brands godoc
@@ -20,6 +21,8 @@ type DeleteBrandsHandler func(context.Context, *BrandRequestParams) error
 //	@Tags		Brand
 //	@Produce	json
 //	@Param		keyword	query		string	false	"Keyword to search"
+//	@Param		sort	query		string	false	"Comma separated sort keys, prefix with - for descending (brand_id, brand, created_at)"
+//	@Param		cursor	query		string	false	"Cursor from a previous next_cursor or prev_cursor, page is ignored when set"
 //	@Param		limit	query		int		false	"Number of entities per page"
 //	@Param		page	query		int		false	"Page number"
 //	@Success	200		{object}	ListofBrands	"Successfully fetched all brands"
@@ -90,6 +93,7 @@ func HandleGetOneBrands(handler GetOneBrandsHandler) echo.HandlerFunc {
 //	@Param		body	body	BrandRequestPayload	true	"Brand details"
 //	@Success	201		{object}	httpres.BaseResponse	"Brand successfully created"
 //	@Failure	400		{object}	httpres.ErrorResponse			"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse			"Role may not manage brands"
 //	@Failure	500		{object}	httpres.ErrorResponse			"Internal server error"
 //	@Router		/brands [post]
 func HandleCreateBrands(handler CreateBrandsHandler) echo.HandlerFunc {
@@ -124,6 +128,7 @@ func HandleCreateBrands(handler CreateBrandsHandler) echo.HandlerFunc {
 //	@Param		body	body	BrandRequestPayload	true	"Updated brand details"
 //	@Success	200		{object}	httpres.BaseResponse	"Brand updated successfully"
 //	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not manage brands"
 //	@Failure	404		{object}	httpres.ErrorResponse	"Brand not found"
 //	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
 //	@Router		/brands/{id} [put]
@@ -157,6 +162,52 @@ func HandleUpdateBrands(handler UpdateBrandsHandler) echo.HandlerFunc {
 	}
 }
 
+// Patch Brand godoc
+//
+//	@Summary	Partially update an existing brand
+//	@Tags		Brand
+//	@Accept		json
+//	@Accept		application/merge-patch+json
+//	@Produce	json
+//	@Param		id		path	string				true	"Brand ID"
+//	@Param		body	body	BrandPatchPayload	true	"Brand fields to change"
+//	@Success	200		{object}	httpres.BaseResponse	"Brand updated successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not manage brands"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Brand not found"
+//	@Failure	415		{object}	httpres.ErrorResponse	"Unsupported media type"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/brands/{id} [patch]
+func HandlePatchBrands(handler PatchBrandsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &BrandRequestParams{}
+		payload := &BrandPatchPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := utils.BindMergePatch(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Brand updated successfully")
+	}
+}
+
 // Delete Brand godoc
 //
 //	@Summary	Delete a brand by ID
@@ -165,6 +216,7 @@ func HandleUpdateBrands(handler UpdateBrandsHandler) echo.HandlerFunc {
 //	@Param		id	path	string	true	"Brand ID"
 //	@Success	200		{object}	httpres.BaseResponse	"Brand deleted successfully"
 //	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not manage brands"
 //	@Failure	404		{object}	httpres.ErrorResponse	"Brand not found"
 //	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
 //	@Router		/brands/{id} [delete]
@@ -1,6 +1,9 @@
 package brands
 
+import "time"
+
 type Brands struct {
-	BrandID int64  `db:"brand_id"`
-	Brand   string `db:"brand"`
-}
\ No newline at end of file
+	BrandID   int64     `db:"brand_id"`
+	Brand     string    `db:"brand"`
+	CreatedAt time.Time `db:"created_at"`
+}
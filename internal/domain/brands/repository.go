@@ -3,6 +3,7 @@ package brands
 import (
 	"context"
 	"database/sql"
+	"strconv"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
@@ -12,13 +13,35 @@ import (
 
 var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 
+var brandsSortColumns = map[string]string{
+	"brand_id":   "b.brand_id",
+	"brand":      "b.brand",
+	"created_at": "b.created_at",
+}
+
+// brandsSortValue returns the value of a sortable column for a row, as stored
+// in a pagination cursor.
+func brandsSortValue(brand *Brands, key string) string {
+	switch key {
+	case "brand_id":
+		return strconv.FormatInt(brand.BrandID, 10)
+	case "brand":
+		return brand.Brand
+	case "created_at":
+		return brand.CreatedAt.Format(utils.CursorTimeFormat)
+	}
+
+	return ""
+}
+
 type BrandsRepository interface {
-	GetAll(context.Context, *BrandRequestQuery) ([]*Brands, error)
-	Count(context.Context, *BrandRequestQuery) (uint64, error)
-	GetByID(context.Context, *BrandRequestParams) (*Brands, error)
-	Add(context.Context, *BrandRequestPayload) error
-	Update(context.Context, *BrandRequestPayload, *BrandRequestParams) error
-	Delete(context.Context, *BrandRequestParams) error
+	GetAll(context.Context, int64, *BrandRequestQuery) ([]*Brands, error)
+	Count(context.Context, int64, *BrandRequestQuery) (uint64, error)
+	GetByID(context.Context, int64, *BrandRequestParams) (*Brands, error)
+	Add(context.Context, int64, *BrandRequestPayload) (int64, error)
+	Update(context.Context, int64, *BrandRequestPayload, *BrandRequestParams) error
+	Patch(context.Context, int64, *BrandPatchPayload, *BrandRequestParams) error
+	Delete(context.Context, int64, *BrandRequestParams) error
 }
 
 type brandsRepository struct {
@@ -31,34 +54,57 @@ func NewRepository(db *sqlx.DB) BrandsRepository {
 	}
 }
 
-func (r *brandsRepository) GetAll(ctx context.Context, query *BrandRequestQuery) (resp []*Brands, err error) {
+func (r *brandsRepository) GetAll(ctx context.Context, workspaceID int64, query *BrandRequestQuery) (resp []*Brands, err error) {
 	keyword := query.Keyword
 	utils.KeywordHelper(&keyword)
 
-	stmt, args, _ := pgSquirell.Select("b.brand_id", "b.brand").From("brands b").Where(squirrel.And{squirrel.Eq{"b.deleted_at": nil}, squirrel.ILike{"b.brand": keyword}}).Limit(query.Limit).Offset((query.Page - 1) * query.Limit).ToSql()
-
 	resp = []*Brands{}
 
+	sortFields, err := utils.ParseSort(query.Sort, brandsSortColumns, "brand_id")
+	if err != nil {
+		return resp, err
+	}
+
+	builder := pgSquirell.Select("b.brand_id", "b.brand", "b.created_at").From("brands b").Where(squirrel.And{squirrel.Eq{"b.deleted_at": nil, "b.workspace_id": workspaceID}, squirrel.ILike{"b.brand": keyword}})
+
+	// one extra row is fetched so the service can tell whether more rows follow
+	if query.Cursor != "" {
+		cursor, err := utils.DecodeCursor(query.Cursor, sortFields)
+		if err != nil {
+			return resp, err
+		}
+		if cursor.Backward {
+			sortFields = utils.ReverseSort(sortFields)
+		}
+
+		builder = builder.Where(utils.KeysetPredicate(sortFields, cursor.Values)).OrderBy(utils.OrderByClauses(sortFields)...).Limit(query.Limit + 1)
+	} else {
+		builder = builder.OrderBy(utils.OrderByClauses(sortFields)...).Limit(query.Limit + 1).Offset((query.Page - 1) * query.Limit)
+	}
+
+	stmt, args, _ := builder.ToSql()
+
 	rows, err := r.db.QueryContext(ctx, stmt, args...)
 	if err != nil {
 		return resp, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		col := &Brands{}
 
-		if err = rows.Scan(&col.BrandID, &col.Brand); err != nil {
+		if err = rows.Scan(&col.BrandID, &col.Brand, &col.CreatedAt); err != nil {
 			return resp, err
 		}
 
 		resp = append(resp, col)
 	}
 
-	return resp, nil
+	return resp, rows.Err()
 }
 
-func (r *brandsRepository) GetByID(ctx context.Context, params *BrandRequestParams) (resp *Brands, err error) {
-	stmt, args, _ := pgSquirell.Select("b.brand_id", "b.brand").From("brands b").Where(squirrel.And{squirrel.Eq{"b.deleted_at": nil}, squirrel.Eq{"b.brand_id": params.BrandID}}).ToSql()
+func (r *brandsRepository) GetByID(ctx context.Context, workspaceID int64, params *BrandRequestParams) (resp *Brands, err error) {
+	stmt, args, _ := pgSquirell.Select("b.brand_id", "b.brand").From("brands b").Where(squirrel.And{squirrel.Eq{"b.deleted_at": nil, "b.workspace_id": workspaceID}, squirrel.Eq{"b.brand_id": params.BrandID}}).ToSql()
 
 	resp = &Brands{}
 
@@ -72,11 +118,11 @@ func (r *brandsRepository) GetByID(ctx context.Context, params *BrandRequestPara
 	return resp, nil
 }
 
-func (r *brandsRepository) Count(ctx context.Context, query *BrandRequestQuery) (resp uint64, err error) {
+func (r *brandsRepository) Count(ctx context.Context, workspaceID int64, query *BrandRequestQuery) (resp uint64, err error) {
 	keyword := query.Keyword
 	utils.KeywordHelper(&keyword)
 
-	stmt, args, _ := pgSquirell.Select("count(brand_id)").From("brands").Where(squirrel.And{squirrel.Eq{"deleted_at": nil}, squirrel.Eq{"brand":keyword}}).ToSql()
+	stmt, args, _ := pgSquirell.Select("count(brand_id)").From("brands").Where(squirrel.And{squirrel.Eq{"deleted_at": nil, "workspace_id": workspaceID}, squirrel.ILike{"brand":keyword}}).ToSql()
 
 	err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&resp)
 	if err != nil && err != sql.ErrNoRows {
@@ -88,10 +134,10 @@ func (r *brandsRepository) Count(ctx context.Context, query *BrandRequestQuery)
 	return resp, nil
 }
 
-func (r *brandsRepository) Add(ctx context.Context, payload *BrandRequestPayload) (err error) {
+func (r *brandsRepository) Add(ctx context.Context, workspaceID int64, payload *BrandRequestPayload) (brandID int64, err error) {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	defer tx.Rollback()
@@ -99,21 +145,21 @@ func (r *brandsRepository) Add(ctx context.Context, payload *BrandRequestPayload
 	var stmt string
 	var args []any
 
-	stmt, args, _ = pgSquirell.Insert("brands").Columns("brand").Values(payload.Brand).ToSql()
+	stmt, args, _ = pgSquirell.Insert("brands").Columns("workspace_id", "brand").Values(workspaceID, payload.Brand).Suffix("RETURNING brand_id").ToSql()
 
-	_, err = tx.ExecContext(ctx, stmt, args...)
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&brandID)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	if err = tx.Commit(); err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return brandID, nil
 }
 
-func (r *brandsRepository) Update(ctx context.Context, payload *BrandRequestPayload, params *BrandRequestParams) (err error) {
+func (r *brandsRepository) Update(ctx context.Context, workspaceID int64, payload *BrandRequestPayload, params *BrandRequestParams) (err error) {
 	tx, err := r.db.BeginTxx(ctx,nil)
 	if err != nil {
 		return err
@@ -124,7 +170,7 @@ func (r *brandsRepository) Update(ctx context.Context, payload *BrandRequestPayl
 	var args []any
 	var count int64
 
-	stmt, args, _ = pgSquirell.Select("count(*)").From("brands").Where(squirrel.And{squirrel.Eq{"deleted_at":nil}, squirrel.Eq{"brand_id":params.BrandID}}).ToSql()
+	stmt, args, _ = pgSquirell.Select("count(*)").From("brands").Where(squirrel.And{squirrel.Eq{"deleted_at":nil, "workspace_id":workspaceID}, squirrel.Eq{"brand_id":params.BrandID}}).ToSql()
 
 	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
 	if err != nil && err != sql.ErrNoRows{
@@ -136,7 +182,49 @@ func (r *brandsRepository) Update(ctx context.Context, payload *BrandRequestPayl
 	stmt, args, _ = pgSquirell.Update("brands").SetMap(map[string]interface{}{
 		"brand":payload.Brand,
 		"updated_at":squirrel.Expr("NOW()"),
-	}).Where(squirrel.Eq{"brand_id":params.BrandID}).ToSql()
+	}).Where(squirrel.Eq{"brand_id":params.BrandID, "workspace_id":workspaceID}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *brandsRepository) Patch(ctx context.Context, workspaceID int64, payload *BrandPatchPayload, params *BrandRequestParams) (err error) {
+	tx, err := r.db.BeginTxx(ctx,nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var stmt string
+	var args []any
+	var count int64
+
+	stmt, args, _ = pgSquirell.Select("count(*)").From("brands").Where(squirrel.And{squirrel.Eq{"deleted_at":nil, "workspace_id":workspaceID}, squirrel.Eq{"brand_id":params.BrandID}}).ToSql()
+
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return err
+	} else if count == 0 {
+		return exceptions.NewNotFoundError("brands not found")
+	}
+
+	setMap := map[string]interface{}{
+		"updated_at":squirrel.Expr("NOW()"),
+	}
+
+	if payload.Brand != nil {
+		setMap["brand"] = *payload.Brand
+	}
+
+	stmt, args, _ = pgSquirell.Update("brands").SetMap(setMap).Where(squirrel.Eq{"brand_id":params.BrandID, "workspace_id":workspaceID}).ToSql()
 
 	_, err = tx.ExecContext(ctx, stmt, args...)
 	if err != nil {
@@ -150,7 +238,7 @@ func (r *brandsRepository) Update(ctx context.Context, payload *BrandRequestPayl
 	return nil
 }
 
-func (r *brandsRepository) Delete(ctx context.Context, params *BrandRequestParams) error {
+func (r *brandsRepository) Delete(ctx context.Context, workspaceID int64, params *BrandRequestParams) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
 
 	if err != nil {
@@ -162,7 +250,7 @@ func (r *brandsRepository) Delete(ctx context.Context, params *BrandRequestParam
 	var args []any
 	var count int64
 
-	stmt, args, _ = pgSquirell.Select("count(*)").From("brands").Where(squirrel.And{squirrel.Eq{"deleted_at":nil}, squirrel.Eq{"brand_id":params.BrandID}}).ToSql()
+	stmt, args, _ = pgSquirell.Select("count(*)").From("brands").Where(squirrel.And{squirrel.Eq{"deleted_at":nil, "workspace_id":workspaceID}, squirrel.Eq{"brand_id":params.BrandID}}).ToSql()
 
 	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
 	if err != nil && err != sql.ErrNoRows{
@@ -173,7 +261,7 @@ func (r *brandsRepository) Delete(ctx context.Context, params *BrandRequestParam
 
 	stmt, args, _ = pgSquirell.Update("brands").SetMap(map[string]interface{}{
 		"deleted_at":squirrel.Expr("NOW()"),
-	}).Where(squirrel.Eq{"brand_id":params.BrandID}).ToSql()
+	}).Where(squirrel.Eq{"brand_id":params.BrandID, "workspace_id":workspaceID}).ToSql()
 
 	_, err = tx.ExecContext(ctx, stmt, args...)
 	if err != nil {
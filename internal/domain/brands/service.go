@@ -3,8 +3,11 @@ package brands
 import (
 	"context"
 
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/events"
 	"github.com/agungramananda/sosmed-todolist/internal/common/httpres"
 	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/rs/zerolog"
 )
 
 type BrandsService interface {
@@ -12,24 +15,34 @@ type BrandsService interface {
 	GetOne(context.Context, *BrandRequestParams) (*BrandDetails, error)
 	Create(context.Context, *BrandRequestPayload) error
 	Update(context.Context, *BrandRequestParams, *BrandRequestPayload) error
+	Patch(context.Context, *BrandRequestParams, *BrandPatchPayload) error
 	Delete(context.Context, *BrandRequestParams) error
 }
 
 type brandsService struct {
 	repo      BrandsRepository
+	publisher events.Publisher
+	logger    *zerolog.Logger
 }
 
-func NewService(r BrandsRepository) *brandsService {
-	return &brandsService{repo: r}
+func NewService(r BrandsRepository, p events.Publisher, logger *zerolog.Logger) *brandsService {
+	return &brandsService{repo: r, publisher: p, logger: logger}
 }
 
 func (svc brandsService) GetAll(ctx context.Context, query *BrandRequestQuery) (listOfBrands *ListofBrands, err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return &ListofBrands{}, err
+	}
+
 	limit := int(query.Limit)
 	page := int(query.Page)
 	utils.SetDefaultPagination(&limit, &page)
 
 	repoQuery := &BrandRequestQuery{
 		Keyword: query.Keyword,
+		Sort: query.Sort,
+		Cursor: query.Cursor,
 		Limit: uint64(limit),
 		Page: uint64(page),
 	}
@@ -44,11 +57,26 @@ func (svc brandsService) GetAll(ctx context.Context, query *BrandRequestQuery) (
 		},
 	}
 
-	brands, err := svc.repo.GetAll(ctx, repoQuery)
+	sortFields, err := utils.ParseSort(repoQuery.Sort, brandsSortColumns, "brand_id")
+	if err != nil {
+		return &ListofBrands{}, err
+	}
+
+	var cursor *utils.Cursor
+	if repoQuery.Cursor != "" {
+		if cursor, err = utils.DecodeCursor(repoQuery.Cursor, sortFields); err != nil {
+			return &ListofBrands{}, err
+		}
+		listOfBrands.Meta.Page = 0
+	}
+
+	brands, err := svc.repo.GetAll(ctx, caller.WorkspaceID, repoQuery)
 	if err != nil {
 		return &ListofBrands{}, err
 	}
 
+	brands = utils.PaginateKeyset(brands, &listOfBrands.Meta, sortFields, cursor, brandsSortValue)
+
 	for _, brand := range brands {
 		listOfBrands.Brands = append(listOfBrands.Brands, &BrandDetails{
 			BrandID: brand.BrandID,
@@ -56,7 +84,12 @@ func (svc brandsService) GetAll(ctx context.Context, query *BrandRequestQuery) (
 		})
 	}
 
-	total_items, err := svc.repo.Count(ctx, repoQuery)
+	// keyset pages skip the COUNT query, which is what makes them cheap
+	if cursor != nil {
+		return listOfBrands, nil
+	}
+
+	total_items, err := svc.repo.Count(ctx, caller.WorkspaceID, repoQuery)
 	if err != nil {
 		return &ListofBrands{}, err
 	}
@@ -67,7 +100,12 @@ func (svc brandsService) GetAll(ctx context.Context, query *BrandRequestQuery) (
 }
 
 func (svc *brandsService) GetOne(ctx context.Context, params *BrandRequestParams) (brandDetails *BrandDetails, err error){
-	brand, err := svc.repo.GetByID(ctx, params)
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return brandDetails, err
+	}
+
+	brand, err := svc.repo.GetByID(ctx, caller.WorkspaceID, params)
 	if err != nil {
 		return brandDetails, err
 	}
@@ -81,28 +119,85 @@ func (svc *brandsService) GetOne(ctx context.Context, params *BrandRequestParams
 }
 
 func (svc *brandsService) Create(ctx context.Context, payload *BrandRequestPayload) (err error) {
-	err = svc.repo.Add(ctx, payload)
+	caller, err := auth.Authorize(ctx, auth.PermManageCatalog)
+	if err != nil {
+		return err
+	}
+
+	brandID, err := svc.repo.Add(ctx, caller.WorkspaceID, payload)
 	if err != nil {
 		return err
 	}
 
+	svc.publisher.Publish(ctx, events.BrandCreated, &BrandDetails{
+		BrandID: brandID,
+		Brand:   payload.Brand,
+	})
+
 	return nil
 }
 
 func (svc *brandsService) Update(ctx context.Context, params *BrandRequestParams, payload *BrandRequestPayload) (err error){
-	err = svc.repo.Update(ctx,payload,params)
+	caller, err := auth.Authorize(ctx, auth.PermManageCatalog)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Update(ctx, caller.WorkspaceID, payload, params)
 	if err != nil {
 		return err
 	}
 
+	svc.publish(ctx, events.BrandUpdated, params)
+
+	return nil
+}
+
+func (svc *brandsService) Patch(ctx context.Context, params *BrandRequestParams, payload *BrandPatchPayload) (err error){
+	caller, err := auth.Authorize(ctx, auth.PermManageCatalog)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Patch(ctx, caller.WorkspaceID, payload, params)
+	if err != nil {
+		return err
+	}
+
+	svc.publish(ctx, events.BrandUpdated, params)
+
 	return nil
 }
 
 func (svc *brandsService) Delete(ctx context.Context, params *BrandRequestParams) (err error){
-	err = svc.repo.Delete(ctx, params)
+	caller, err := auth.Authorize(ctx, auth.PermManageCatalog)
+	if err != nil {
+		return err
+	}
+
+	brandDetails, err := svc.GetOne(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Delete(ctx, caller.WorkspaceID, params)
 	if err != nil {
 		return err
 	}
 
+	svc.publisher.Publish(ctx, events.BrandDeleted, brandDetails)
+
 	return nil
 }
+
+// publish sends event with the brand as it is now. The change is already
+// saved, so a failure to read the brand back is only logged.
+func (svc *brandsService) publish(ctx context.Context, event string, params *BrandRequestParams) {
+	brandDetails, err := svc.GetOne(context.WithoutCancel(ctx), params)
+	if err != nil {
+		svc.logger.Error().Err(err).Str("event", event).Msg("failed to publish webhook event")
+		return
+	}
+
+	svc.publisher.Publish(ctx, event, brandDetails)
+}
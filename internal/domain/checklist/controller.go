@@ -0,0 +1,31 @@
+package checklist
+
+import (
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type ChecklistController struct {
+	svc ChecklistService
+}
+
+func NewController(svc ChecklistService) *ChecklistController {
+	return &ChecklistController{
+		svc: svc,
+	}
+}
+
+const (
+	checklistBasepath = "/tasks/:task_id/checklist"
+)
+
+func (con *ChecklistController) Route(grp *echo.Group){
+	subrouter := grp.Group(checklistBasepath, middleware.RequireScopes(auth.ScopeTasksRead, auth.ScopeTasksWrite))
+
+	subrouter.GET("", HandleGetAllChecklist(con.svc.GetAll))
+	subrouter.POST("", HandleCreateChecklist(con.svc.Create))
+	subrouter.PUT("/order", HandleReorderChecklist(con.svc.Reorder))
+	subrouter.PATCH("/:item_id", HandlePatchChecklist(con.svc.Patch))
+	subrouter.DELETE("/:item_id", HandleDeleteChecklist(con.svc.Delete))
+}
@@ -0,0 +1,42 @@
+package checklist
+
+type ChecklistListParams struct {
+	TaskID string `param:"task_id" validate:"required"`
+}
+
+type ChecklistRequestParams struct {
+	TaskID string `param:"task_id" validate:"required"`
+	ItemID string `param:"item_id" validate:"required"`
+}
+
+type ChecklistRequestPayload struct {
+	Title    string `json:"title" validate:"required,max=255" example:"Brand approval"`
+	Required bool   `json:"required" example:"true"`
+}
+
+// ChecklistPatchPayload is a JSON Merge Patch of a checklist item, only the
+// fields present in the request are validated and updated.
+type ChecklistPatchPayload struct {
+	Title     *string `json:"title" validate:"omitnil,min=1,max=255"`
+	Required  *bool   `json:"required"`
+	Completed *bool   `json:"completed"`
+}
+
+// ChecklistOrderPayload lists every item of the checklist in its new order.
+type ChecklistOrderPayload struct {
+	ItemIDs []int64 `json:"item_ids" validate:"required,min=1,max=100,dive,min=1"`
+}
+
+type ChecklistItemDetails struct {
+	ItemID      int64   `json:"item_id"`
+	Title       string  `json:"title" example:"Brand approval"`
+	Position    int     `json:"position" example:"1"`
+	Required    bool    `json:"required"`
+	Completed   bool    `json:"completed"`
+	CompletedAt *string `json:"completed_at" example:"2026-10-18T09:30:00Z"`
+}
+
+type ListofChecklistItems struct {
+	Items    []*ChecklistItemDetails `json:"items"`
+	Progress *int                    `json:"progress" example:"60"`
+}
@@ -0,0 +1,216 @@
+package checklist
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type GetAllChecklistHandler func(context.Context, *ChecklistListParams) (*ListofChecklistItems, error)
+type CreateChecklistHandler func(context.Context, *ChecklistListParams, *ChecklistRequestPayload) error
+type PatchChecklistHandler func(context.Context, *ChecklistRequestParams, *ChecklistPatchPayload) error
+type DeleteChecklistHandler func(context.Context, *ChecklistRequestParams) error
+type ReorderChecklistHandler func(context.Context, *ChecklistListParams, *ChecklistOrderPayload) error
+
+// Get Checklist godoc
+//
+//	@Summary	Get the checklist of a task in order
+//	@Tags		Checklist
+//	@Produce	json
+//	@Param		task_id	path		string	true	"Task ID"
+//	@Success	200		{object}	ListofChecklistItems	"Successfully fetched the checklist"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Task not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/checklist [get]
+func HandleGetAllChecklist(handler GetAllChecklistHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &ChecklistListParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "Checklist fetched successfully")
+	}
+}
+
+// Create Checklist Item godoc
+//
+//	@Summary		Add an item to the end of the checklist of a task
+//	@Description	A task cannot be completed while one of its required items is open.
+//	@Tags			Checklist
+//	@Accept			json
+//	@Produce		json
+//	@Param			task_id	path		string					true	"Task ID"
+//	@Param			request	body		ChecklistRequestPayload	true	"Checklist Item Request Payload"
+//	@Success		201		{object}	httpres.BaseResponse	"Checklist item successfully created"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Role may not change the task"
+//	@Failure		404		{object}	httpres.ErrorResponse	"Task not found"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/tasks/{task_id}/checklist [post]
+func HandleCreateChecklist(handler CreateChecklistHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &ChecklistListParams{}
+		payload := &ChecklistRequestPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusCreated, nil, "Checklist item successfully created")
+	}
+}
+
+// Patch Checklist Item godoc
+//
+//	@Summary	Partially update a checklist item, such as ticking it off
+//	@Tags		Checklist
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id	path	string					true	"Task ID"
+//	@Param		item_id	path	string					true	"Checklist Item ID"
+//	@Param		body	body	ChecklistPatchPayload	true	"Fields to update"
+//	@Success	200		{object}	httpres.BaseResponse	"Checklist item updated successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not change the task"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Checklist item not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/checklist/{item_id} [patch]
+func HandlePatchChecklist(handler PatchChecklistHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &ChecklistRequestParams{}
+		payload := &ChecklistPatchPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Checklist item updated successfully")
+	}
+}
+
+// Delete Checklist Item godoc
+//
+//	@Summary	Delete a checklist item
+//	@Tags		Checklist
+//	@Produce	json
+//	@Param		task_id	path	string	true	"Task ID"
+//	@Param		item_id	path	string	true	"Checklist Item ID"
+//	@Success	200		{object}	httpres.BaseResponse	"Checklist item deleted successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not change the task"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Checklist item not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/checklist/{item_id} [delete]
+func HandleDeleteChecklist(handler DeleteChecklistHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &ChecklistRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Checklist item deleted successfully")
+	}
+}
+
+// Reorder Checklist godoc
+//
+//	@Summary	Reorder the checklist of a task
+//	@Tags		Checklist
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id	path	string					true	"Task ID"
+//	@Param		body	body	ChecklistOrderPayload	true	"Every item ID of the checklist in the new order"
+//	@Success	200		{object}	httpres.BaseResponse	"Checklist reordered successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not change the task"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Task not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/checklist/order [put]
+func HandleReorderChecklist(handler ReorderChecklistHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &ChecklistListParams{}
+		payload := &ChecklistOrderPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Checklist reordered successfully")
+	}
+}
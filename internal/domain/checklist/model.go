@@ -0,0 +1,18 @@
+package checklist
+
+import (
+	"database/sql"
+	"time"
+)
+
+type ChecklistItems struct {
+	ItemID      int64        `db:"item_id"`
+	TaskID      int64        `db:"task_id"`
+	Title       string       `db:"title"`
+	Position    int          `db:"position"`
+	Required    bool         `db:"required"`
+	Completed   bool         `db:"completed"`
+	CompletedAt sql.NullTime `db:"completed_at"`
+	CreatedAt   time.Time    `db:"created_at"`
+	UpdatedAt   time.Time    `db:"updated_at"`
+}
@@ -0,0 +1,190 @@
+package checklist
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/jmoiron/sqlx"
+)
+
+var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+// maxItems caps the length of a checklist.
+const maxItems = 100
+
+type ChecklistRepository interface {
+	GetAll(context.Context, *ChecklistListParams) ([]*ChecklistItems, error)
+	Add(context.Context, *ChecklistListParams, *ChecklistRequestPayload) error
+	Patch(context.Context, *ChecklistRequestParams, *ChecklistPatchPayload) error
+	Delete(context.Context, *ChecklistRequestParams) error
+	Reorder(context.Context, *ChecklistListParams, []int64) error
+}
+
+type checklistRepository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) ChecklistRepository {
+	return &checklistRepository{
+		db: db,
+	}
+}
+
+func (r *checklistRepository) GetAll(ctx context.Context, params *ChecklistListParams) (resp []*ChecklistItems, err error) {
+	stmt, args, _ := pgSquirell.Select("item_id", "task_id", "title", "position", "required", "completed", "completed_at", "created_at", "updated_at").
+						From("task_checklist_items").
+						Where(squirrel.Eq{"task_id": params.TaskID, "deleted_at": nil}).
+						OrderBy("position ASC", "item_id ASC").
+						ToSql()
+
+	resp = []*ChecklistItems{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// Add appends an item to the end of the checklist.
+func (r *checklistRepository) Add(ctx context.Context, params *ChecklistListParams, payload *ChecklistRequestPayload) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	var count int64
+	var last int
+
+	// lock the task so concurrent appends do not get the same position
+	stmt, args, _ := pgSquirell.Select("task_id").From("tasks").Where(squirrel.Eq{"task_id": params.TaskID}).Suffix("FOR UPDATE").ToSql()
+	if _, err = tx.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+
+	stmt, args, _ = pgSquirell.Select("count(*)", "COALESCE(MAX(position), 0)").From("task_checklist_items").Where(squirrel.Eq{"task_id": params.TaskID, "deleted_at": nil}).ToSql()
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count, &last)
+	if err != nil {
+		return err
+	} else if count >= maxItems {
+		return exceptions.NewInvariantError(fmt.Sprintf("a checklist can have at most %d items", maxItems))
+	}
+
+	stmt, args, _ = pgSquirell.Insert("task_checklist_items").Columns("task_id", "title", "position", "required").Values(params.TaskID, payload.Title, last+1, payload.Required).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *checklistRepository) Patch(ctx context.Context, params *ChecklistRequestParams, payload *ChecklistPatchPayload) (err error) {
+	setMap := map[string]interface{}{
+		"updated_at": squirrel.Expr("NOW()"),
+	}
+
+	if payload.Title != nil {
+		setMap["title"] = *payload.Title
+	}
+
+	if payload.Required != nil {
+		setMap["required"] = *payload.Required
+	}
+
+	if payload.Completed != nil {
+		setMap["completed"] = *payload.Completed
+		if *payload.Completed {
+			setMap["completed_at"] = squirrel.Expr("COALESCE(completed_at, NOW())")
+		} else {
+			setMap["completed_at"] = nil
+		}
+	}
+
+	stmt, args, _ := pgSquirell.Update("task_checklist_items").SetMap(setMap).Where(squirrel.Eq{"item_id": params.ItemID, "task_id": params.TaskID, "deleted_at": nil}).ToSql()
+
+	result, err := r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("checklist items not found")
+	}
+
+	return nil
+}
+
+func (r *checklistRepository) Delete(ctx context.Context, params *ChecklistRequestParams) (err error) {
+	stmt, args, _ := pgSquirell.Update("task_checklist_items").SetMap(map[string]interface{}{
+		"deleted_at": squirrel.Expr("NOW()"),
+	}).Where(squirrel.Eq{"item_id": params.ItemID, "task_id": params.TaskID, "deleted_at": nil}).ToSql()
+
+	result, err := r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("checklist items not found")
+	}
+
+	return nil
+}
+
+// Reorder numbers the items in the order of itemIDs, which must list every
+// item of the checklist once.
+func (r *checklistRepository) Reorder(ctx context.Context, params *ChecklistListParams, itemIDs []int64) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	current := []int64{}
+
+	stmt, args, _ := pgSquirell.Select("item_id").From("task_checklist_items").Where(squirrel.Eq{"task_id": params.TaskID, "deleted_at": nil}).Suffix("FOR UPDATE").ToSql()
+	if err = tx.SelectContext(ctx, &current, stmt, args...); err != nil {
+		return err
+	}
+
+	sorted := slices.Clone(itemIDs)
+	slices.Sort(sorted)
+	slices.Sort(current)
+	if !slices.Equal(sorted, current) {
+		return exceptions.NewInvariantError("item_ids must list every item of the checklist once")
+	}
+
+	for i, itemID := range itemIDs {
+		stmt, args, _ = pgSquirell.Update("task_checklist_items").SetMap(map[string]interface{}{
+			"position":   i + 1,
+			"updated_at": squirrel.Expr("NOW()"),
+		}).Where(squirrel.Eq{"item_id": itemID}).ToSql()
+
+		if _, err = tx.ExecContext(ctx, stmt, args...); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package checklist
+
+import (
+	"context"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+)
+
+type ChecklistService interface {
+	GetAll(context.Context, *ChecklistListParams) (*ListofChecklistItems, error)
+	Create(context.Context, *ChecklistListParams, *ChecklistRequestPayload) error
+	Patch(context.Context, *ChecklistRequestParams, *ChecklistPatchPayload) error
+	Delete(context.Context, *ChecklistRequestParams) error
+	Reorder(context.Context, *ChecklistListParams, *ChecklistOrderPayload) error
+}
+
+type checklistService struct {
+	repo       ChecklistRepository
+	taskOwners auth.TaskOwners
+}
+
+func NewService(r ChecklistRepository, taskOwners auth.TaskOwners) *checklistService {
+	return &checklistService{repo: r, taskOwners: taskOwners}
+}
+
+func (svc *checklistService) GetAll(ctx context.Context, params *ChecklistListParams) (listOfItems *ListofChecklistItems, err error) {
+	if _, err = auth.ReadTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return listOfItems, err
+	}
+
+	items, err := svc.repo.GetAll(ctx, params)
+	if err != nil {
+		return listOfItems, err
+	}
+
+	listOfItems = &ListofChecklistItems{
+		Items: []*ChecklistItemDetails{},
+	}
+
+	completed := 0
+	for _, item := range items {
+		details := &ChecklistItemDetails{
+			ItemID:    item.ItemID,
+			Title:     item.Title,
+			Position:  item.Position,
+			Required:  item.Required,
+			Completed: item.Completed,
+		}
+		if item.CompletedAt.Valid {
+			completedAt := item.CompletedAt.Time.Format(time.RFC3339)
+			details.CompletedAt = &completedAt
+		}
+		if item.Completed {
+			completed++
+		}
+
+		listOfItems.Items = append(listOfItems.Items, details)
+	}
+
+	if len(items) > 0 {
+		progress := completed * 100 / len(items)
+		listOfItems.Progress = &progress
+	}
+
+	return listOfItems, nil
+}
+
+func (svc *checklistService) Create(ctx context.Context, params *ChecklistListParams, payload *ChecklistRequestPayload) (err error) {
+	if _, err = auth.EditTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return err
+	}
+
+	err = svc.repo.Add(ctx, params, payload)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *checklistService) Patch(ctx context.Context, params *ChecklistRequestParams, payload *ChecklistPatchPayload) (err error) {
+	if _, err = auth.EditTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return err
+	}
+
+	err = svc.repo.Patch(ctx, params, payload)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *checklistService) Delete(ctx context.Context, params *ChecklistRequestParams) (err error) {
+	if _, err = auth.EditTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return err
+	}
+
+	err = svc.repo.Delete(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *checklistService) Reorder(ctx context.Context, params *ChecklistListParams, payload *ChecklistOrderPayload) (err error) {
+	if _, err = auth.EditTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return err
+	}
+
+	err = svc.repo.Reorder(ctx, params, payload.ItemIDs)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package comments
+
+import (
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type CommentsController struct {
+	svc CommentsService
+}
+
+func NewController(svc CommentsService) *CommentsController {
+	return &CommentsController{
+		svc: svc,
+	}
+}
+
+const (
+	commentsBasepath = "/tasks/:task_id/comments"
+)
+
+func (con *CommentsController) Route(grp *echo.Group){
+	subrouter := grp.Group(commentsBasepath, middleware.RequireScopes(auth.ScopeTasksRead, auth.ScopeTasksWrite))
+
+	subrouter.GET("", HandleGetAllComments(con.svc.GetAll))
+	subrouter.POST("", HandleCreateComment(con.svc.Create))
+	subrouter.PUT("/:comment_id", HandleEditComment(con.svc.Edit))
+	subrouter.DELETE("/:comment_id", HandleDeleteComment(con.svc.Delete))
+}
@@ -0,0 +1,55 @@
+package comments
+
+import "github.com/agungramananda/sosmed-todolist/internal/common/httpres"
+
+type CommentListParams struct {
+	TaskID string `param:"task_id" validate:"required"`
+}
+
+type CommentRequestParams struct {
+	TaskID    string `param:"task_id" validate:"required"`
+	CommentID string `param:"comment_id" validate:"required"`
+}
+
+// CommentRequestQuery pages through the threads of a task with cursors only,
+// comments keep arriving while they are read.
+type CommentRequestQuery struct {
+	Sort   string `query:"sort" validate:"omitempty,max=100"`
+	Cursor string `query:"cursor" validate:"omitempty,max=1000"`
+	Limit  uint64 `query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type CommentRequestPayload struct {
+	// ParentID makes the comment a reply, replying to a reply continues the
+	// same thread.
+	ParentID *int64 `json:"parent_id" validate:"omitnil,min=1"`
+	Body     string `json:"body" validate:"required,max=5000" example:"Can we move the CTA to the first slide?"`
+}
+
+type CommentEditPayload struct {
+	Body string `json:"body" validate:"required,max=5000"`
+}
+
+// CommentDetails of a deleted comment that still has replies keeps its place
+// in the thread without its author and body. AuthorID is null for comments
+// written before their author was recorded and once the author is removed.
+type CommentDetails struct {
+	CommentID int64   `json:"comment_id"`
+	ParentID  *int64  `json:"parent_id"`
+	AuthorID  *int64  `json:"author_id" example:"3"`
+	Author    string  `json:"author" example:"Dina"`
+	Body      string  `json:"body" example:"Can we move the CTA to the first slide?"`
+	Deleted   bool    `json:"deleted"`
+	EditedAt  *string `json:"edited_at" example:"2026-10-18T09:45:00Z"`
+	CreatedAt string  `json:"created_at" example:"2026-10-18T09:30:00Z"`
+}
+
+type CommentThreadDetails struct {
+	CommentDetails
+	Replies []*CommentDetails `json:"replies"`
+}
+
+type ListofComments struct {
+	Comments []*CommentThreadDetails `json:"comments"`
+	Meta     httpres.ListPagination  `json:"meta"`
+}
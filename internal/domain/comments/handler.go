@@ -0,0 +1,184 @@
+package comments
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type GetAllCommentsHandler func(context.Context, *CommentListParams, *CommentRequestQuery) (*ListofComments, error)
+type CreateCommentHandler func(context.Context, *CommentListParams, *CommentRequestPayload) error
+type EditCommentHandler func(context.Context, *CommentRequestParams, *CommentEditPayload) error
+type DeleteCommentHandler func(context.Context, *CommentRequestParams) error
+
+// Get Comments godoc
+//
+//	@Summary		Get the comment threads of a task
+//	@Description	Every thread holds all of its replies. A deleted comment that still has replies is returned with deleted set and without its author and body.
+//	@Tags			Comments
+//	@Produce		json
+//	@Param			task_id	path		string	true	"Task ID"
+//	@Param			sort	query		string	false	"Comma separated sort keys, prefix with - for descending (comment_id, created_at)"
+//	@Param			cursor	query		string	false	"Cursor from a previous next_cursor or prev_cursor"
+//	@Param			limit	query		int		false	"Number of threads per page"
+//	@Success		200		{object}	ListofComments			"Successfully fetched comments"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		404		{object}	httpres.ErrorResponse	"Task not found"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/tasks/{task_id}/comments [get]
+func HandleGetAllComments(handler GetAllCommentsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &CommentListParams{}
+		query := &CommentRequestQuery{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindQueryParams(c, query); err != nil {
+			return err
+		}
+
+		if err := c.Validate(query); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, params, query)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "Comments fetched successfully")
+	}
+}
+
+// Create Comment godoc
+//
+//	@Summary		Comment on a task or reply to a comment
+//	@Description	The caller is recorded as the author. A reply to a reply is added to the same thread.
+//	@Tags			Comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			task_id	path		string					true	"Task ID"
+//	@Param			request	body		CommentRequestPayload	true	"Comment Request Payload"
+//	@Success		201		{object}	httpres.BaseResponse	"Comment successfully created"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Role may not change the task"
+//	@Failure		404		{object}	httpres.ErrorResponse	"Task or parent comment not found"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/tasks/{task_id}/comments [post]
+func HandleCreateComment(handler CreateCommentHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &CommentListParams{}
+		payload := &CommentRequestPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusCreated, nil, "Comment successfully created")
+	}
+}
+
+// Edit Comment godoc
+//
+//	@Summary	Edit the body of a comment
+//	@Tags		Comments
+//	@Accept		json
+//	@Produce	json
+//	@Param		task_id		path	string				true	"Task ID"
+//	@Param		comment_id	path	string				true	"Comment ID"
+//	@Param		body		body	CommentEditPayload	true	"New body of the comment"
+//	@Success	200			{object}	httpres.BaseResponse	"Comment updated successfully"
+//	@Failure	400			{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403			{object}	httpres.ErrorResponse	"Caller did not write the comment"
+//	@Failure	404			{object}	httpres.ErrorResponse	"Comment not found"
+//	@Failure	500			{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/comments/{comment_id} [put]
+func HandleEditComment(handler EditCommentHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &CommentRequestParams{}
+		payload := &CommentEditPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Comment updated successfully")
+	}
+}
+
+// Delete Comment godoc
+//
+//	@Summary	Delete a comment
+//	@Tags		Comments
+//	@Produce	json
+//	@Param		task_id		path	string	true	"Task ID"
+//	@Param		comment_id	path	string	true	"Comment ID"
+//	@Success	200			{object}	httpres.BaseResponse	"Comment deleted successfully"
+//	@Failure	400			{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403			{object}	httpres.ErrorResponse	"Caller did not write the comment"
+//	@Failure	404			{object}	httpres.ErrorResponse	"Comment not found"
+//	@Failure	500			{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/comments/{comment_id} [delete]
+func HandleDeleteComment(handler DeleteCommentHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &CommentRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Comment deleted successfully")
+	}
+}
@@ -0,0 +1,18 @@
+package comments
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Comments struct {
+	CommentID int64         `db:"comment_id"`
+	TaskID    int64         `db:"task_id"`
+	ParentID  sql.NullInt64 `db:"parent_id"`
+	AuthorID  sql.NullInt64 `db:"author_id"`
+	Author    string        `db:"author"`
+	Body      string        `db:"body"`
+	EditedAt  sql.NullTime  `db:"edited_at"`
+	CreatedAt time.Time     `db:"created_at"`
+	DeletedAt sql.NullTime  `db:"deleted_at"`
+}
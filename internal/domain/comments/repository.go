@@ -0,0 +1,213 @@
+package comments
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/jmoiron/sqlx"
+)
+
+var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+var commentsSortColumns = map[string]string{
+	"comment_id": "c.comment_id",
+	"created_at": "c.created_at",
+}
+
+var commentsColumns = []string{"c.comment_id", "c.task_id", "c.parent_id", "c.author_id", "COALESCE(u.name, c.author, '') AS author", "c.body", "c.edited_at", "c.created_at", "c.deleted_at"}
+
+// selectComments joins the author, the name of a user who is gone falls back
+// to the one stored with the comment.
+func selectComments() squirrel.SelectBuilder {
+	return pgSquirell.Select(commentsColumns...).
+				From("task_comments c").
+				LeftJoin("users u ON u.user_id=c.author_id")
+}
+
+// commentsSortValue returns the value of a sortable column for a row, as
+// stored in a pagination cursor.
+func commentsSortValue(comment *Comments, key string) string {
+	switch key {
+	case "comment_id":
+		return strconv.FormatInt(comment.CommentID, 10)
+	case "created_at":
+		return comment.CreatedAt.Format(utils.CursorTimeFormat)
+	}
+
+	return ""
+}
+
+type CommentsRepository interface {
+	GetThreads(context.Context, *CommentListParams, *CommentRequestQuery) ([]*Comments, error)
+	GetReplies(context.Context, []int64) ([]*Comments, error)
+	GetAuthor(context.Context, *CommentRequestParams) (int64, error)
+	Add(context.Context, *CommentListParams, int64, *CommentRequestPayload) error
+	Edit(context.Context, *CommentRequestParams, *CommentEditPayload) error
+	Delete(context.Context, *CommentRequestParams) error
+}
+
+type commentsRepository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) CommentsRepository {
+	return &commentsRepository{
+		db: db,
+	}
+}
+
+// GetThreads lists the first comments of the threads of a task. A deleted
+// comment is kept as long as one of its replies is not.
+func (r *commentsRepository) GetThreads(ctx context.Context, params *CommentListParams, query *CommentRequestQuery) (resp []*Comments, err error) {
+	resp = []*Comments{}
+
+	sortFields, err := utils.ParseSort(query.Sort, commentsSortColumns, "comment_id")
+	if err != nil {
+		return resp, err
+	}
+
+	liveReplies := squirrel.Select("1").From("task_comments rc").Where("rc.parent_id=c.comment_id AND rc.deleted_at IS NULL")
+
+	builder := selectComments().
+					Where(squirrel.And{
+						squirrel.Eq{"c.task_id": params.TaskID, "c.parent_id": nil},
+						squirrel.Or{squirrel.Eq{"c.deleted_at": nil}, squirrel.Expr("EXISTS (?)", liveReplies)},
+					})
+
+	// one extra row is fetched so the service can tell whether more rows follow
+	if query.Cursor != "" {
+		cursor, err := utils.DecodeCursor(query.Cursor, sortFields)
+		if err != nil {
+			return resp, err
+		}
+		if cursor.Backward {
+			sortFields = utils.ReverseSort(sortFields)
+		}
+
+		builder = builder.Where(utils.KeysetPredicate(sortFields, cursor.Values))
+	}
+
+	stmt, args, _ := builder.OrderBy(utils.OrderByClauses(sortFields)...).Limit(query.Limit + 1).ToSql()
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// GetReplies lists the replies of the given comments, oldest first.
+func (r *commentsRepository) GetReplies(ctx context.Context, parentIDs []int64) (resp []*Comments, err error) {
+	resp = []*Comments{}
+	if len(parentIDs) == 0 {
+		return resp, nil
+	}
+
+	stmt, args, _ := selectComments().
+						Where(squirrel.Eq{"c.parent_id": parentIDs, "c.deleted_at": nil}).
+						OrderBy("c.comment_id ASC").
+						ToSql()
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// GetAuthor returns the user who wrote a comment, 0 when it is not known.
+func (r *commentsRepository) GetAuthor(ctx context.Context, params *CommentRequestParams) (authorID int64, err error) {
+	var author sql.NullInt64
+
+	stmt, args, _ := pgSquirell.Select("author_id").
+						From("task_comments").
+						Where(squirrel.Eq{"comment_id": params.CommentID, "task_id": params.TaskID, "deleted_at": nil}).
+						ToSql()
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&author)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	} else if err == sql.ErrNoRows {
+		return 0, exceptions.NewNotFoundError("comments not found")
+	}
+
+	return author.Int64, nil
+}
+
+// Add stores a comment written by authorID. A reply to a reply is attached to
+// the first comment of the thread, so threads stay one level deep.
+func (r *commentsRepository) Add(ctx context.Context, params *CommentListParams, authorID int64, payload *CommentRequestPayload) (err error) {
+	var parentID sql.NullInt64
+
+	if payload.ParentID != nil {
+		stmt, args, _ := pgSquirell.Select("COALESCE(parent_id, comment_id)").
+							From("task_comments").
+							Where(squirrel.Eq{"comment_id": *payload.ParentID, "task_id": params.TaskID, "deleted_at": nil}).
+							ToSql()
+
+		err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&parentID)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		} else if err == sql.ErrNoRows {
+			return exceptions.NewNotFoundError("parent comment not found")
+		}
+	}
+
+	stmt, args, _ := pgSquirell.Insert("task_comments").
+						Columns("task_id", "parent_id", "author_id", "body").
+						Values(params.TaskID, parentID, authorID, payload.Body).
+						ToSql()
+
+	_, err = r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *commentsRepository) Edit(ctx context.Context, params *CommentRequestParams, payload *CommentEditPayload) (err error) {
+	stmt, args, _ := pgSquirell.Update("task_comments").SetMap(map[string]interface{}{
+		"body":       payload.Body,
+		"edited_at":  squirrel.Expr("NOW()"),
+		"updated_at": squirrel.Expr("NOW()"),
+	}).Where(squirrel.Eq{"comment_id": params.CommentID, "task_id": params.TaskID, "deleted_at": nil}).ToSql()
+
+	result, err := r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("comments not found")
+	}
+
+	return nil
+}
+
+func (r *commentsRepository) Delete(ctx context.Context, params *CommentRequestParams) (err error) {
+	stmt, args, _ := pgSquirell.Update("task_comments").SetMap(map[string]interface{}{
+		"deleted_at": squirrel.Expr("NOW()"),
+	}).Where(squirrel.Eq{"comment_id": params.CommentID, "task_id": params.TaskID, "deleted_at": nil}).ToSql()
+
+	result, err := r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("comments not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package comments
+
+import (
+	"context"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/agungramananda/sosmed-todolist/internal/common/httpres"
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+)
+
+type CommentsService interface {
+	GetAll(context.Context, *CommentListParams, *CommentRequestQuery) (*ListofComments, error)
+	Create(context.Context, *CommentListParams, *CommentRequestPayload) error
+	Edit(context.Context, *CommentRequestParams, *CommentEditPayload) error
+	Delete(context.Context, *CommentRequestParams) error
+}
+
+type commentsService struct {
+	repo       CommentsRepository
+	taskOwners auth.TaskOwners
+}
+
+func NewService(r CommentsRepository, taskOwners auth.TaskOwners) *commentsService {
+	return &commentsService{repo: r, taskOwners: taskOwners}
+}
+
+func (svc *commentsService) GetAll(ctx context.Context, params *CommentListParams, query *CommentRequestQuery) (listOfComments *ListofComments, err error) {
+	if _, err = auth.ReadTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return listOfComments, err
+	}
+
+	limit := int(query.Limit)
+	page := 1
+	utils.SetDefaultPagination(&limit, &page)
+
+	repoQuery := &CommentRequestQuery{
+		Sort:   query.Sort,
+		Cursor: query.Cursor,
+		Limit:  uint64(limit),
+	}
+
+	listOfComments = &ListofComments{
+		Comments: []*CommentThreadDetails{},
+		Meta: httpres.ListPagination{
+			Limit: repoQuery.Limit,
+		},
+	}
+
+	sortFields, err := utils.ParseSort(repoQuery.Sort, commentsSortColumns, "comment_id")
+	if err != nil {
+		return &ListofComments{}, err
+	}
+
+	var cursor *utils.Cursor
+	if repoQuery.Cursor != "" {
+		if cursor, err = utils.DecodeCursor(repoQuery.Cursor, sortFields); err != nil {
+			return &ListofComments{}, err
+		}
+	}
+
+	threads, err := svc.repo.GetThreads(ctx, params, repoQuery)
+	if err != nil {
+		return &ListofComments{}, err
+	}
+
+	threads = utils.PaginateKeyset(threads, &listOfComments.Meta, sortFields, cursor, commentsSortValue)
+
+	parentIDs := make([]int64, 0, len(threads))
+	byID := map[int64]*CommentThreadDetails{}
+	for _, comment := range threads {
+		thread := &CommentThreadDetails{
+			CommentDetails: *commentDetails(comment),
+			Replies:        []*CommentDetails{},
+		}
+
+		parentIDs = append(parentIDs, comment.CommentID)
+		byID[comment.CommentID] = thread
+		listOfComments.Comments = append(listOfComments.Comments, thread)
+	}
+
+	replies, err := svc.repo.GetReplies(ctx, parentIDs)
+	if err != nil {
+		return &ListofComments{}, err
+	}
+
+	for _, reply := range replies {
+		if thread, ok := byID[reply.ParentID.Int64]; ok {
+			thread.Replies = append(thread.Replies, commentDetails(reply))
+		}
+	}
+
+	return listOfComments, nil
+}
+
+func (svc *commentsService) Create(ctx context.Context, params *CommentListParams, payload *CommentRequestPayload) (err error) {
+	caller, err := auth.EditTask(ctx, svc.taskOwners, params.TaskID)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Add(ctx, params, caller.ID, payload)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *commentsService) Edit(ctx context.Context, params *CommentRequestParams, payload *CommentEditPayload) (err error) {
+	if err = svc.editableComment(ctx, params); err != nil {
+		return err
+	}
+
+	err = svc.repo.Edit(ctx, params, payload)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *commentsService) Delete(ctx context.Context, params *CommentRequestParams) (err error) {
+	if err = svc.editableComment(ctx, params); err != nil {
+		return err
+	}
+
+	err = svc.repo.Delete(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// editableComment checks that the caller may change a comment. Only its author
+// may, or a role that may change any task.
+func (svc *commentsService) editableComment(ctx context.Context, params *CommentRequestParams) error {
+	caller, err := auth.Authorize(ctx, auth.PermEditOwnTasks)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err = svc.taskOwners(ctx, caller.WorkspaceID, params.TaskID); err != nil {
+		return err
+	}
+
+	authorID, err := svc.repo.GetAuthor(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	if authorID != caller.ID && !caller.Can(auth.PermEditTasks) {
+		return exceptions.NewForbiddenError("you can only change comments you wrote")
+	}
+
+	return nil
+}
+
+func commentDetails(comment *Comments) *CommentDetails {
+	details := &CommentDetails{
+		CommentID: comment.CommentID,
+		CreatedAt: comment.CreatedAt.Format(time.RFC3339),
+	}
+	if comment.ParentID.Valid {
+		parentID := comment.ParentID.Int64
+		details.ParentID = &parentID
+	}
+
+	if comment.DeletedAt.Valid {
+		details.Deleted = true
+		return details
+	}
+
+	if comment.AuthorID.Valid {
+		authorID := comment.AuthorID.Int64
+		details.AuthorID = &authorID
+	}
+	details.Author = comment.Author
+	details.Body = comment.Body
+	if comment.EditedAt.Valid {
+		editedAt := comment.EditedAt.Time.Format(time.RFC3339)
+		details.EditedAt = &editedAt
+	}
+
+	return details
+}
@@ -1,12 +1,31 @@
 package domain
 
 import (
+	"strings"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/config"
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
 	"github.com/agungramananda/sosmed-todolist/internal/common/custom_validator"
 	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
 	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/agungramananda/sosmed-todolist/internal/common/webhook"
+	"github.com/agungramananda/sosmed-todolist/internal/common/worker"
+	"github.com/agungramananda/sosmed-todolist/internal/domain/apikeys"
+	"github.com/agungramananda/sosmed-todolist/internal/domain/attachments"
 	"github.com/agungramananda/sosmed-todolist/internal/domain/brands"
+	"github.com/agungramananda/sosmed-todolist/internal/domain/checklist"
+	"github.com/agungramananda/sosmed-todolist/internal/domain/comments"
+	"github.com/agungramananda/sosmed-todolist/internal/domain/health"
 	"github.com/agungramananda/sosmed-todolist/internal/domain/platforms"
+	"github.com/agungramananda/sosmed-todolist/internal/domain/reminders"
+	"github.com/agungramananda/sosmed-todolist/internal/domain/series"
+	"github.com/agungramananda/sosmed-todolist/internal/domain/tags"
 	"github.com/agungramananda/sosmed-todolist/internal/domain/tasks"
+	"github.com/agungramananda/sosmed-todolist/internal/domain/users"
+	"github.com/agungramananda/sosmed-todolist/internal/domain/webhooks"
+	"github.com/agungramananda/sosmed-todolist/internal/domain/workspaces"
+	"github.com/agungramananda/sosmed-todolist/internal/storage"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/labstack/echo/v4"
@@ -15,29 +34,102 @@ import (
 	echoSwagger "github.com/swaggo/echo-swagger"
 )
 
-func InitDomain(db *sqlx.DB, e *echo.Echo, logger *zerolog.Logger, validator *custom_validator.Validator){
+// webhookTimeout bounds a single delivery attempt to a webhook URL, for
+// reminders and subscriptions alike.
+const webhookTimeout = 10 * time.Second
+
+// Job is a background job of a domain, run periodically by the server until
+// it shuts down.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      worker.Job
+}
+
+func InitDomain(db *sqlx.DB, e *echo.Echo, logger *zerolog.Logger, validator *custom_validator.Validator, store storage.Storage, reminderConf *config.ReminderConfig, authConf *config.AuthConfig) []Job {
+	tokens := auth.NewTokens(authConf.JWTSecret, authConf.AccessTTL, authConf.RefreshTTL)
+
+	//workspaces and api keys, needed by the authentication of every route
+	workspacesRepo := workspaces.NewRepository(db)
+	workspacesSvc := workspaces.NewService(workspacesRepo)
+	apiKeysRepo := apikeys.NewRepository(db)
+	apiKeysSvc := apikeys.NewService(apiKeysRepo)
+
 	e.GET("/api/swagger/*", echoSwagger.WrapHandler)
+	e.GET("/api/health", health.HandleHealth(db.PingContext))
 	root := e.Group("/api/v1",
 		ecmiddleware.RequestIDWithConfig(ecmiddleware.RequestIDConfig{Generator: uuid.NewString}),
 		ecmiddleware.CORS(),
 		middleware.RequestLogger(logger),
+		middleware.Authenticate(tokens, workspacesSvc.Authenticate, apiKeysSvc.Authenticate, func(c echo.Context) bool {
+			return strings.HasPrefix(c.Path(), "/api/v1"+users.AuthBasepath+"/")
+		}),
 	)
 
 	e.Validator = validator
 	e.HTTPErrorHandler = exceptions.CustomHTTPErrorHandler(*logger)
 
+	//users
+	usersRepo := users.NewRepository(db)
+	usersSvc := users.NewService(usersRepo, tokens)
+	users.NewController(usersSvc).Route(root)
+	apikeys.NewController(apiKeysSvc).Route(root)
+	workspaces.NewController(workspacesSvc).Route(root)
+
+	//webhooks
+	webhooksRepo := webhooks.NewRepository(db)
+	webhooksSvc := webhooks.NewService(webhooksRepo, webhook.NewClient(webhookTimeout), logger)
+	webhooks.NewController(webhooksSvc).Route(root)
+
 	//brands
 	brandsRepo := brands.NewRepository(db)
-	brandsSvc := brands.NewService(brandsRepo)
+	brandsSvc := brands.NewService(brandsRepo, webhooksSvc, logger)
 	brands.NewController(brandsSvc).Route(root)
 
 	//platforms
 	platformsRepo := platforms.NewRepository(db)
-	platformsSvc := platforms.NewService(platformsRepo)
+	platformsSvc := platforms.NewService(platformsRepo, webhooksSvc, logger)
 	platforms.NewController(platformsSvc).Route(root)
 
+	//tags
+	tagsRepo := tags.NewRepository(db)
+	tagsSvc := tags.NewService(tagsRepo)
+	tags.NewController(tagsSvc).Route(root)
+
 	//tasks
 	tasksRepo := tasks.NewRepository(db)
-	tasksSvc := tasks.NewService(tasksRepo)
+	tasksSvc := tasks.NewService(tasksRepo, validator, webhooksSvc, logger)
 	tasks.NewController(tasksSvc).Route(root)
-}
\ No newline at end of file
+
+	//attachments
+	attachmentsRepo := attachments.NewRepository(db)
+	attachmentsSvc := attachments.NewService(attachmentsRepo, tasksRepo.GetOwners, store)
+	attachments.NewController(attachmentsSvc).Route(root)
+
+	//checklist
+	checklistRepo := checklist.NewRepository(db)
+	checklistSvc := checklist.NewService(checklistRepo, tasksRepo.GetOwners)
+	checklist.NewController(checklistSvc).Route(root)
+
+	//comments
+	commentsRepo := comments.NewRepository(db)
+	commentsSvc := comments.NewService(commentsRepo, tasksRepo.GetOwners)
+	comments.NewController(commentsSvc).Route(root)
+
+	//series
+	seriesRepo := series.NewRepository(db)
+	seriesSvc := series.NewService(seriesRepo)
+	series.NewController(seriesSvc).Route(root)
+
+	//reminders
+	remindersRepo := reminders.NewRepository(db)
+	remindersSvc := reminders.NewService(remindersRepo, tasksRepo.GetOwners, webhook.NewClient(webhookTimeout), reminderConf.WebhookURLs)
+	reminders.NewController(remindersSvc).Route(root)
+
+	return []Job{
+		{Name: "series materializer", Interval: time.Hour, Run: seriesSvc.MaterializeAll},
+		{Name: "overdue sweeper", Interval: 5 * time.Minute, Run: tasksSvc.SweepOverdue},
+		{Name: "reminder dispatcher", Interval: time.Minute, Run: remindersSvc.Dispatch},
+		{Name: "webhook dispatcher", Interval: 10 * time.Second, Run: webhooksSvc.Dispatch},
+	}
+}
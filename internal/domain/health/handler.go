@@ -0,0 +1,22 @@
+package health
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PingHandler func(context.Context) error
+
+// HandleHealth reports whether the service can reach its database. It is
+// served outside /api/v1 and needs no token, so probes can call it.
+func HandleHealth(ping PingHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if err := ping(c.Request().Context()); err != nil {
+			return c.JSON(http.StatusServiceUnavailable, map[string]string{"status": "unavailable"})
+		}
+
+		return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
+	}
+}
@@ -1,6 +1,10 @@
 package platforms
 
-import "github.com/labstack/echo/v4"
+import (
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/labstack/echo/v4"
+)
 
 type PlatformsController struct {
 	svc PlatformsService
@@ -17,11 +21,12 @@ const (
 )
 
 func (con *PlatformsController) Route(grp *echo.Group){
-	subrouter := grp.Group(platformsBasepath)
+	subrouter := grp.Group(platformsBasepath, middleware.RequireScopes(auth.ScopePlatformsRead, auth.ScopePlatformsWrite))
 
 	subrouter.GET("", HandleGetAllPlatforms(con.svc.GetAll))
 	subrouter.GET("/:platform_id", HandleGetOnePlatforms(con.svc.GetOne))
 	subrouter.POST("",HandleCreatePlatforms(con.svc.Create))
 	subrouter.PUT("/:platform_id", HandleUpdatePlatforms(con.svc.Update))
+	subrouter.PATCH("/:platform_id", HandlePatchPlatforms(con.svc.Patch))
 	subrouter.DELETE("/:platform_id", HandleDeletePlatforms(con.svc.Delete))
 }
\ No newline at end of file
@@ -11,8 +11,16 @@ type PlatformRequestPayload struct {
 	Platform   string `json:"platform" validate:"required,max=200,min=1"`
 }
 
+// PlatformPatchPayload is a JSON Merge Patch of a platform, only the fields present
+// in the request are validated and updated.
+type PlatformPatchPayload struct {
+	Platform *string `json:"platform" validate:"omitnil,max=200,min=1"`
+}
+
 type PlatformRequestQuery struct {
 	Keyword string `query:"keyword" validate:"omitempty,max=100"`
+	Sort    string `query:"sort" validate:"omitempty,max=100"`
+	Cursor  string `query:"cursor" validate:"omitempty,max=1000"`
 	Limit   uint64 `query:"limit" validate:"omitempty,min=1,max=100"`
 	Page    uint64 `query:"page" validate:"omitempty,min=1"`
 }
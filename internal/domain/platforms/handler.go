@@ -12,6 +12,7 @@ type GetAllPlatformsHandler func(context.Context, *PlatformRequestQuery) (*Listo
 type GetOnePlatformsHandler func(context.Context, *PlatformRequestParams) (*PlatformDetails, error)
 type CreatePlatformsHandler func(context.Context, *PlatformRequestPayload) error
 type UpdatePlatformsHandler func(context.Context, *PlatformRequestParams, *PlatformRequestPayload) error
+type PatchPlatformsHandler func(context.Context, *PlatformRequestParams, *PlatformPatchPayload) error
 type DeletePlatformsHandler func(context.Context, *PlatformRequestParams) error
 
 // Get All Platforms godoc
@@ -20,6 +21,8 @@ type DeletePlatformsHandler func(context.Context, *PlatformRequestParams) error
 //	@Tags		Platform
 //	@Produce	json
 //	@Param		keyword	query		string	false	"Keyword to search"
+//	@Param		sort	query		string	false	"Comma separated sort keys, prefix with - for descending (platform_id, platform, created_at)"
+//	@Param		cursor	query		string	false	"Cursor from a previous next_cursor or prev_cursor, page is ignored when set"
 //	@Param		limit	query		int		false	"Number of entities per page"
 //	@Param		page	query		int		false	"Page number"
 //	@Success	200		{object}	ListofPlatforms	"Successfully fetched all platforms"
@@ -90,6 +93,7 @@ func HandleGetOnePlatforms(handler GetOnePlatformsHandler) echo.HandlerFunc {
 //	@Param		body	body	PlatformRequestPayload	true	"Platform details"
 //	@Success	201		{object}	httpres.BaseResponse	"Platform successfully created"
 //	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not manage platforms"
 //	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
 //	@Router		/platforms [post]
 func HandleCreatePlatforms(handler CreatePlatformsHandler) echo.HandlerFunc {
@@ -124,6 +128,7 @@ func HandleCreatePlatforms(handler CreatePlatformsHandler) echo.HandlerFunc {
 //	@Param		body	body	PlatformRequestPayload	true	"Updated platform details"
 //	@Success	200		{object}	httpres.BaseResponse	"Platform updated successfully"
 //	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not manage platforms"
 //	@Failure	404		{object}	httpres.ErrorResponse	"Platform not found"
 //	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
 //	@Router		/platforms/{id} [put]
@@ -157,6 +162,52 @@ func HandleUpdatePlatforms(handler UpdatePlatformsHandler) echo.HandlerFunc {
 	}
 }
 
+// Patch Platform godoc
+//
+//	@Summary	Partially update an existing platform
+//	@Tags		Platform
+//	@Accept		json
+//	@Accept		application/merge-patch+json
+//	@Produce	json
+//	@Param		id		path	string				true	"Platform ID"
+//	@Param		body	body	PlatformPatchPayload	true	"Platform fields to change"
+//	@Success	200		{object}	httpres.BaseResponse	"Platform updated successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not manage platforms"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Platform not found"
+//	@Failure	415		{object}	httpres.ErrorResponse	"Unsupported media type"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/platforms/{id} [patch]
+func HandlePatchPlatforms(handler PatchPlatformsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &PlatformRequestParams{}
+		payload := &PlatformPatchPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := utils.BindMergePatch(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Platform updated successfully")
+	}
+}
+
 // Delete Platform godoc
 //
 //	@Summary	Delete a platform by ID
@@ -165,6 +216,7 @@ func HandleUpdatePlatforms(handler UpdatePlatformsHandler) echo.HandlerFunc {
 //	@Param		id	path	string	true	"Platform ID"
 //	@Success	200		{object}	httpres.BaseResponse	"Platform deleted successfully"
 //	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not manage platforms"
 //	@Failure	404		{object}	httpres.ErrorResponse	"Platform not found"
 //	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
 //	@Router		/platforms/{id} [delete]
@@ -1,6 +1,9 @@
 package platforms
 
+import "time"
+
 type Platforms struct {
-	PlatformID int64  `db:"platform_id"`
-	Platform   string `db:"platform"`
-}
\ No newline at end of file
+	PlatformID int64     `db:"platform_id"`
+	Platform   string    `db:"platform"`
+	CreatedAt  time.Time `db:"created_at"`
+}
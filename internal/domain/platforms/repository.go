@@ -3,6 +3,7 @@ package platforms
 import (
 	"context"
 	"database/sql"
+	"strconv"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
@@ -12,13 +13,35 @@ import (
 
 var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 
+var platformsSortColumns = map[string]string{
+	"platform_id":   "p.platform_id",
+	"platform":      "p.platform",
+	"created_at": "p.created_at",
+}
+
+// platformsSortValue returns the value of a sortable column for a row, as stored
+// in a pagination cursor.
+func platformsSortValue(platform *Platforms, key string) string {
+	switch key {
+	case "platform_id":
+		return strconv.FormatInt(platform.PlatformID, 10)
+	case "platform":
+		return platform.Platform
+	case "created_at":
+		return platform.CreatedAt.Format(utils.CursorTimeFormat)
+	}
+
+	return ""
+}
+
 type PlatformsRepository interface {
-	GetAll(context.Context, *PlatformRequestQuery) ([]*Platforms, error)
-	Count(context.Context, *PlatformRequestQuery) (uint64, error)
-	GetByID(context.Context, *PlatformRequestParams) (*Platforms, error)
-	Add(context.Context, *PlatformRequestPayload) error
-	Update(context.Context, *PlatformRequestPayload, *PlatformRequestParams) error
-	Delete(context.Context, *PlatformRequestParams) error
+	GetAll(context.Context, int64, *PlatformRequestQuery) ([]*Platforms, error)
+	Count(context.Context, int64, *PlatformRequestQuery) (uint64, error)
+	GetByID(context.Context, int64, *PlatformRequestParams) (*Platforms, error)
+	Add(context.Context, int64, *PlatformRequestPayload) (int64, error)
+	Update(context.Context, int64, *PlatformRequestPayload, *PlatformRequestParams) error
+	Patch(context.Context, int64, *PlatformPatchPayload, *PlatformRequestParams) error
+	Delete(context.Context, int64, *PlatformRequestParams) error
 }
 
 type platformsRepository struct {
@@ -31,34 +54,57 @@ func NewRepository(db *sqlx.DB) PlatformsRepository {
 	}
 }
 
-func (r *platformsRepository) GetAll(ctx context.Context, query *PlatformRequestQuery) (resp []*Platforms, err error) {
+func (r *platformsRepository) GetAll(ctx context.Context, workspaceID int64, query *PlatformRequestQuery) (resp []*Platforms, err error) {
 	keyword := query.Keyword
 	utils.KeywordHelper(&keyword)
 
-	stmt, args, _ := pgSquirell.Select("p.platform_id", "p.platform").From("platforms p").Where(squirrel.And{squirrel.Eq{"p.deleted_at": nil}, squirrel.ILike{"p.platform": keyword}}).Limit(query.Limit).Offset((query.Page - 1) * query.Limit).ToSql()
-
 	resp = []*Platforms{}
 
+	sortFields, err := utils.ParseSort(query.Sort, platformsSortColumns, "platform_id")
+	if err != nil {
+		return resp, err
+	}
+
+	builder := pgSquirell.Select("p.platform_id", "p.platform", "p.created_at").From("platforms p").Where(squirrel.And{squirrel.Eq{"p.deleted_at": nil, "p.workspace_id": workspaceID}, squirrel.ILike{"p.platform": keyword}})
+
+	// one extra row is fetched so the service can tell whether more rows follow
+	if query.Cursor != "" {
+		cursor, err := utils.DecodeCursor(query.Cursor, sortFields)
+		if err != nil {
+			return resp, err
+		}
+		if cursor.Backward {
+			sortFields = utils.ReverseSort(sortFields)
+		}
+
+		builder = builder.Where(utils.KeysetPredicate(sortFields, cursor.Values)).OrderBy(utils.OrderByClauses(sortFields)...).Limit(query.Limit + 1)
+	} else {
+		builder = builder.OrderBy(utils.OrderByClauses(sortFields)...).Limit(query.Limit + 1).Offset((query.Page - 1) * query.Limit)
+	}
+
+	stmt, args, _ := builder.ToSql()
+
 	rows, err := r.db.QueryContext(ctx, stmt, args...)
 	if err != nil {
 		return resp, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		col := &Platforms{}
 
-		if err = rows.Scan(&col.PlatformID, &col.Platform); err != nil {
+		if err = rows.Scan(&col.PlatformID, &col.Platform, &col.CreatedAt); err != nil {
 			return resp, err
 		}
 
 		resp = append(resp, col)
 	}
 
-	return resp, nil
+	return resp, rows.Err()
 }
 
-func (r *platformsRepository) GetByID(ctx context.Context, params *PlatformRequestParams) (resp *Platforms, err error) {
-	stmt, args, _ := pgSquirell.Select("p.platform_id", "p.platform").From("platforms p").Where(squirrel.And{squirrel.Eq{"p.deleted_at": nil}, squirrel.Eq{"p.platform_id": params.PlatformID}}).ToSql()
+func (r *platformsRepository) GetByID(ctx context.Context, workspaceID int64, params *PlatformRequestParams) (resp *Platforms, err error) {
+	stmt, args, _ := pgSquirell.Select("p.platform_id", "p.platform").From("platforms p").Where(squirrel.And{squirrel.Eq{"p.deleted_at": nil, "p.workspace_id": workspaceID}, squirrel.Eq{"p.platform_id": params.PlatformID}}).ToSql()
 
 	resp = &Platforms{}
 
@@ -72,11 +118,11 @@ func (r *platformsRepository) GetByID(ctx context.Context, params *PlatformReque
 	return resp, nil
 }
 
-func (r *platformsRepository) Count(ctx context.Context, query *PlatformRequestQuery) (resp uint64, err error) {
+func (r *platformsRepository) Count(ctx context.Context, workspaceID int64, query *PlatformRequestQuery) (resp uint64, err error) {
 	keyword := query.Keyword
 	utils.KeywordHelper(&keyword)
 
-	stmt, args, _ := pgSquirell.Select("count(platform_id)").From("platforms").Where(squirrel.And{squirrel.Eq{"deleted_at": nil}, squirrel.ILike{"platform":keyword}}).ToSql()
+	stmt, args, _ := pgSquirell.Select("count(platform_id)").From("platforms").Where(squirrel.And{squirrel.Eq{"deleted_at": nil, "workspace_id": workspaceID}, squirrel.ILike{"platform":keyword}}).ToSql()
 
 	err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&resp)
 	if err != nil && err != sql.ErrNoRows {
@@ -88,10 +134,10 @@ func (r *platformsRepository) Count(ctx context.Context, query *PlatformRequestQ
 	return resp, nil
 }
 
-func (r *platformsRepository) Add(ctx context.Context, payload *PlatformRequestPayload) (err error) {
+func (r *platformsRepository) Add(ctx context.Context, workspaceID int64, payload *PlatformRequestPayload) (platformID int64, err error) {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	defer tx.Rollback()
@@ -99,21 +145,21 @@ func (r *platformsRepository) Add(ctx context.Context, payload *PlatformRequestP
 	var stmt string
 	var args []any
 
-	stmt, args, _ = pgSquirell.Insert("platforms").Columns("platform").Values(payload.Platform).ToSql()
+	stmt, args, _ = pgSquirell.Insert("platforms").Columns("workspace_id", "platform").Values(workspaceID, payload.Platform).Suffix("RETURNING platform_id").ToSql()
 
-	_, err = tx.ExecContext(ctx, stmt, args...)
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&platformID)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	if err = tx.Commit(); err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return platformID, nil
 }
 
-func (r *platformsRepository) Update(ctx context.Context, payload *PlatformRequestPayload, params *PlatformRequestParams) (err error) {
+func (r *platformsRepository) Update(ctx context.Context, workspaceID int64, payload *PlatformRequestPayload, params *PlatformRequestParams) (err error) {
 	tx, err := r.db.BeginTxx(ctx,nil)
 	if err != nil {
 		return err
@@ -124,7 +170,7 @@ func (r *platformsRepository) Update(ctx context.Context, payload *PlatformReque
 	var args []any
 	var count int64
 
-	stmt, args, _ = pgSquirell.Select("count(*)").From("platforms").Where(squirrel.And{squirrel.Eq{"deleted_at":nil}, squirrel.Eq{"platform_id":params.PlatformID}}).ToSql()
+	stmt, args, _ = pgSquirell.Select("count(*)").From("platforms").Where(squirrel.And{squirrel.Eq{"deleted_at":nil, "workspace_id":workspaceID}, squirrel.Eq{"platform_id":params.PlatformID}}).ToSql()
 
 	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
 	if err != nil && err != sql.ErrNoRows{
@@ -136,7 +182,49 @@ func (r *platformsRepository) Update(ctx context.Context, payload *PlatformReque
 	stmt, args, _ = pgSquirell.Update("platforms").SetMap(map[string]interface{}{
 		"platform":payload.Platform,
 		"updated_at":squirrel.Expr("NOW()"),
-	}).Where(squirrel.Eq{"platform_id":params.PlatformID}).ToSql()
+	}).Where(squirrel.Eq{"platform_id":params.PlatformID, "workspace_id":workspaceID}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *platformsRepository) Patch(ctx context.Context, workspaceID int64, payload *PlatformPatchPayload, params *PlatformRequestParams) (err error) {
+	tx, err := r.db.BeginTxx(ctx,nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var stmt string
+	var args []any
+	var count int64
+
+	stmt, args, _ = pgSquirell.Select("count(*)").From("platforms").Where(squirrel.And{squirrel.Eq{"deleted_at":nil, "workspace_id":workspaceID}, squirrel.Eq{"platform_id":params.PlatformID}}).ToSql()
+
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return err
+	} else if count == 0 {
+		return exceptions.NewNotFoundError("platforms not found")
+	}
+
+	setMap := map[string]interface{}{
+		"updated_at":squirrel.Expr("NOW()"),
+	}
+
+	if payload.Platform != nil {
+		setMap["platform"] = *payload.Platform
+	}
+
+	stmt, args, _ = pgSquirell.Update("platforms").SetMap(setMap).Where(squirrel.Eq{"platform_id":params.PlatformID, "workspace_id":workspaceID}).ToSql()
 
 	_, err = tx.ExecContext(ctx, stmt, args...)
 	if err != nil {
@@ -150,7 +238,7 @@ func (r *platformsRepository) Update(ctx context.Context, payload *PlatformReque
 	return nil
 }
 
-func (r *platformsRepository) Delete(ctx context.Context, params *PlatformRequestParams) error {
+func (r *platformsRepository) Delete(ctx context.Context, workspaceID int64, params *PlatformRequestParams) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
 
 	if err != nil {
@@ -162,7 +250,7 @@ func (r *platformsRepository) Delete(ctx context.Context, params *PlatformReques
 	var args []any
 	var count int64
 
-	stmt, args, _ = pgSquirell.Select("count(*)").From("platforms").Where(squirrel.And{squirrel.Eq{"deleted_at":nil}, squirrel.Eq{"platform_id":params.PlatformID}}).ToSql()
+	stmt, args, _ = pgSquirell.Select("count(*)").From("platforms").Where(squirrel.And{squirrel.Eq{"deleted_at":nil, "workspace_id":workspaceID}, squirrel.Eq{"platform_id":params.PlatformID}}).ToSql()
 
 	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
 	if err != nil && err != sql.ErrNoRows{
@@ -173,7 +261,7 @@ func (r *platformsRepository) Delete(ctx context.Context, params *PlatformReques
 
 	stmt, args, _ = pgSquirell.Update("platforms").SetMap(map[string]interface{}{
 		"deleted_at":squirrel.Expr("NOW()"),
-	}).Where(squirrel.Eq{"platform_id":params.PlatformID}).ToSql()
+	}).Where(squirrel.Eq{"platform_id":params.PlatformID, "workspace_id":workspaceID}).ToSql()
 
 	_, err = tx.ExecContext(ctx, stmt, args...)
 	if err != nil {
@@ -3,8 +3,11 @@ package platforms
 import (
 	"context"
 
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/events"
 	"github.com/agungramananda/sosmed-todolist/internal/common/httpres"
 	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/rs/zerolog"
 )
 
 type PlatformsService interface {
@@ -12,24 +15,34 @@ type PlatformsService interface {
 	GetOne(context.Context, *PlatformRequestParams) (*PlatformDetails, error)
 	Create(context.Context, *PlatformRequestPayload) error
 	Update(context.Context, *PlatformRequestParams, *PlatformRequestPayload) error
+	Patch(context.Context, *PlatformRequestParams, *PlatformPatchPayload) error
 	Delete(context.Context, *PlatformRequestParams) error
 }
 
 type platformsService struct {
 	repo      PlatformsRepository
+	publisher events.Publisher
+	logger    *zerolog.Logger
 }
 
-func NewService(r PlatformsRepository) *platformsService {
-	return &platformsService{repo: r}
+func NewService(r PlatformsRepository, p events.Publisher, logger *zerolog.Logger) *platformsService {
+	return &platformsService{repo: r, publisher: p, logger: logger}
 }
 
 func (svc platformsService) GetAll(ctx context.Context, query *PlatformRequestQuery) (listOfPlatforms *ListofPlatforms, err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return &ListofPlatforms{}, err
+	}
+
 	limit := int(query.Limit)
 	page := int(query.Page)
 	utils.SetDefaultPagination(&limit, &page)
 
 	repoQuery := &PlatformRequestQuery{
 		Keyword: query.Keyword,
+		Sort: query.Sort,
+		Cursor: query.Cursor,
 		Limit: uint64(limit),
 		Page: uint64(page),
 	}
@@ -44,11 +57,26 @@ func (svc platformsService) GetAll(ctx context.Context, query *PlatformRequestQu
 		},
 	}
 
-	platforms, err := svc.repo.GetAll(ctx, repoQuery)
+	sortFields, err := utils.ParseSort(repoQuery.Sort, platformsSortColumns, "platform_id")
+	if err != nil {
+		return &ListofPlatforms{}, err
+	}
+
+	var cursor *utils.Cursor
+	if repoQuery.Cursor != "" {
+		if cursor, err = utils.DecodeCursor(repoQuery.Cursor, sortFields); err != nil {
+			return &ListofPlatforms{}, err
+		}
+		listOfPlatforms.Meta.Page = 0
+	}
+
+	platforms, err := svc.repo.GetAll(ctx, caller.WorkspaceID, repoQuery)
 	if err != nil {
 		return &ListofPlatforms{}, err
 	}
 
+	platforms = utils.PaginateKeyset(platforms, &listOfPlatforms.Meta, sortFields, cursor, platformsSortValue)
+
 	for _, platform := range platforms {
 		listOfPlatforms.Platforms = append(listOfPlatforms.Platforms, &PlatformDetails{
 			PlatformID: platform.PlatformID,
@@ -56,7 +84,12 @@ func (svc platformsService) GetAll(ctx context.Context, query *PlatformRequestQu
 		})
 	}
 
-	total_items, err := svc.repo.Count(ctx, repoQuery)
+	// keyset pages skip the COUNT query, which is what makes them cheap
+	if cursor != nil {
+		return listOfPlatforms, nil
+	}
+
+	total_items, err := svc.repo.Count(ctx, caller.WorkspaceID, repoQuery)
 	if err != nil {
 		return &ListofPlatforms{}, err
 	}
@@ -67,7 +100,12 @@ func (svc platformsService) GetAll(ctx context.Context, query *PlatformRequestQu
 }
 
 func (svc *platformsService) GetOne(ctx context.Context, params *PlatformRequestParams) (platformDetails *PlatformDetails, err error){
-	platform, err := svc.repo.GetByID(ctx, params)
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return platformDetails, err
+	}
+
+	platform, err := svc.repo.GetByID(ctx, caller.WorkspaceID, params)
 	if err != nil {
 		return platformDetails, err
 	}
@@ -81,28 +119,85 @@ func (svc *platformsService) GetOne(ctx context.Context, params *PlatformRequest
 }
 
 func (svc *platformsService) Create(ctx context.Context, payload *PlatformRequestPayload) (err error) {
-	err = svc.repo.Add(ctx, payload)
+	caller, err := auth.Authorize(ctx, auth.PermManageCatalog)
+	if err != nil {
+		return err
+	}
+
+	platformID, err := svc.repo.Add(ctx, caller.WorkspaceID, payload)
 	if err != nil {
 		return err
 	}
 
+	svc.publisher.Publish(ctx, events.PlatformCreated, &PlatformDetails{
+		PlatformID: platformID,
+		Platform:   payload.Platform,
+	})
+
 	return nil
 }
 
 func (svc *platformsService) Update(ctx context.Context, params *PlatformRequestParams, payload *PlatformRequestPayload) (err error){
-	err = svc.repo.Update(ctx,payload,params)
+	caller, err := auth.Authorize(ctx, auth.PermManageCatalog)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Update(ctx, caller.WorkspaceID, payload, params)
 	if err != nil {
 		return err
 	}
 
+	svc.publish(ctx, events.PlatformUpdated, params)
+
+	return nil
+}
+
+func (svc *platformsService) Patch(ctx context.Context, params *PlatformRequestParams, payload *PlatformPatchPayload) (err error){
+	caller, err := auth.Authorize(ctx, auth.PermManageCatalog)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Patch(ctx, caller.WorkspaceID, payload, params)
+	if err != nil {
+		return err
+	}
+
+	svc.publish(ctx, events.PlatformUpdated, params)
+
 	return nil
 }
 
 func (svc *platformsService) Delete(ctx context.Context, params *PlatformRequestParams) (err error){
-	err = svc.repo.Delete(ctx, params)
+	caller, err := auth.Authorize(ctx, auth.PermManageCatalog)
+	if err != nil {
+		return err
+	}
+
+	platformDetails, err := svc.GetOne(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Delete(ctx, caller.WorkspaceID, params)
 	if err != nil {
 		return err
 	}
 
+	svc.publisher.Publish(ctx, events.PlatformDeleted, platformDetails)
+
 	return nil
 }
+
+// publish sends event with the platform as it is now. The change is already
+// saved, so a failure to read the platform back is only logged.
+func (svc *platformsService) publish(ctx context.Context, event string, params *PlatformRequestParams) {
+	platformDetails, err := svc.GetOne(context.WithoutCancel(ctx), params)
+	if err != nil {
+		svc.logger.Error().Err(err).Str("event", event).Msg("failed to publish webhook event")
+		return
+	}
+
+	svc.publisher.Publish(ctx, event, platformDetails)
+}
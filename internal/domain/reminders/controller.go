@@ -0,0 +1,30 @@
+package reminders
+
+import (
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type RemindersController struct {
+	svc RemindersService
+}
+
+func NewController(svc RemindersService) *RemindersController {
+	return &RemindersController{
+		svc: svc,
+	}
+}
+
+const (
+	remindersBasepath = "/tasks/:task_id/reminders"
+)
+
+func (con *RemindersController) Route(grp *echo.Group){
+	subrouter := grp.Group(remindersBasepath, middleware.RequireScopes(auth.ScopeTasksRead, auth.ScopeTasksWrite))
+
+	subrouter.GET("", HandleGetAllReminders(con.svc.GetAll))
+	subrouter.POST("", HandleCreateReminders(con.svc.Create))
+	subrouter.DELETE("/:reminder_id", HandleDeleteReminders(con.svc.Delete))
+	subrouter.GET("/:reminder_id/deliveries", HandleGetDeliveries(con.svc.GetDeliveries))
+}
@@ -0,0 +1,77 @@
+package reminders
+
+const (
+	DeliveryPending   = "Pending"
+	DeliverySucceeded = "Succeeded"
+	DeliveryFailed    = "Failed"
+)
+
+type ReminderListParams struct {
+	TaskID string `param:"task_id" validate:"required"`
+}
+
+type ReminderRequestParams struct {
+	TaskID     string `param:"task_id" validate:"required"`
+	ReminderID string `param:"reminder_id" validate:"required"`
+}
+
+type ReminderRequestPayload struct {
+	Offset string `json:"offset" validate:"required,max=32" example:"24h before"`
+}
+
+type ReminderDetails struct {
+	ReminderID    int64   `json:"reminder_id"`
+	TaskID        int64   `json:"task_id"`
+	Offset        string  `json:"offset" example:"24h0m0s"`
+	OffsetMinutes int64   `json:"offset_minutes" example:"1440"`
+	RemindAt      string  `json:"remind_at" example:"2026-11-01T00:00:00Z"`
+	FiredAt       *string `json:"fired_at" example:"2026-11-01T00:00:12Z"`
+	CreatedAt     string  `json:"created_at" example:"2026-10-18T09:30:00Z"`
+}
+
+type ListofReminders struct {
+	Reminders []*ReminderDetails `json:"reminders"`
+}
+
+type DeliveryDetails struct {
+	DeliveryID     int64   `json:"delivery_id"`
+	ReminderID     int64   `json:"reminder_id"`
+	URL            string  `json:"url" example:"https://hooks.example.com/reminders"`
+	Status         string  `json:"status" enums:"Pending,Succeeded,Failed"`
+	Attempts       int     `json:"attempts" example:"1"`
+	LastStatusCode *int64  `json:"last_status_code" example:"200"`
+	LastError      string  `json:"last_error"`
+	NextAttemptAt  *string `json:"next_attempt_at" example:"2026-11-01T00:01:12Z"`
+	DeliveredAt    *string `json:"delivered_at" example:"2026-11-01T00:00:12Z"`
+	CreatedAt      string  `json:"created_at" example:"2026-11-01T00:00:12Z"`
+}
+
+type ListofDeliveries struct {
+	Deliveries []*DeliveryDetails `json:"deliveries"`
+}
+
+// ReminderWebhookPayload is the JSON body posted to the webhook URLs.
+type ReminderWebhookPayload struct {
+	Event    string                  `json:"event" example:"task.reminder"`
+	FiredAt  string                  `json:"fired_at" example:"2026-11-01T00:00:12Z"`
+	Reminder ReminderWebhookReminder `json:"reminder"`
+	Task     ReminderWebhookTask     `json:"task"`
+}
+
+type ReminderWebhookReminder struct {
+	ReminderID    int64  `json:"reminder_id"`
+	Offset        string `json:"offset"`
+	OffsetMinutes int64  `json:"offset_minutes"`
+}
+
+type ReminderWebhookTask struct {
+	TaskID     int64  `json:"task_id"`
+	Title      string `json:"title"`
+	BrandID    int64  `json:"brand_id"`
+	Brand      string `json:"brand"`
+	PlatformID int64  `json:"platform_id"`
+	Platform   string `json:"platform"`
+	DueDate    string `json:"due_date"`
+	Payment    string `json:"payment"`
+	Status     string `json:"status"`
+}
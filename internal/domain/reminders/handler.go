@@ -0,0 +1,160 @@
+package reminders
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type GetAllRemindersHandler func(context.Context, *ReminderListParams) (*ListofReminders, error)
+type CreateRemindersHandler func(context.Context, *ReminderListParams, *ReminderRequestPayload) error
+type DeleteRemindersHandler func(context.Context, *ReminderRequestParams) error
+type GetDeliveriesHandler func(context.Context, *ReminderRequestParams) (*ListofDeliveries, error)
+
+// Get All Reminders godoc
+//
+//	@Summary	Get all reminders of a task
+//	@Tags		Reminder
+//	@Produce	json
+//	@Param		task_id	path		string	true	"Task ID"
+//	@Success	200		{object}	ListofReminders			"Successfully fetched all reminders"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Task not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/reminders [get]
+func HandleGetAllReminders(handler GetAllRemindersHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &ReminderListParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "All reminders fetched successfully")
+	}
+}
+
+// Create Reminder godoc
+//
+//	@Summary		Create a reminder for a task
+//	@Description	The offset is how long before the due date the reminder fires, such as "24h before" or "90m".
+//	@Tags			Reminder
+//	@Accept			json
+//	@Produce		json
+//	@Param			task_id	path		string					true	"Task ID"
+//	@Param			request	body		ReminderRequestPayload	true	"Reminder Request Payload"
+//	@Success		201		{object}	httpres.BaseResponse	"Reminder successfully created"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Role may not change the task"
+//	@Failure		404		{object}	httpres.ErrorResponse	"Task not found"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/tasks/{task_id}/reminders [post]
+func HandleCreateReminders(handler CreateRemindersHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &ReminderListParams{}
+		payload := &ReminderRequestPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusCreated, nil, "Reminder successfully created")
+	}
+}
+
+// Delete Reminder godoc
+//
+//	@Summary	Delete a reminder
+//	@Tags		Reminder
+//	@Produce	json
+//	@Param		task_id		path	string	true	"Task ID"
+//	@Param		reminder_id	path	string	true	"Reminder ID"
+//	@Success	200		{object}	httpres.BaseResponse	"Reminder deleted successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not change the task"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Reminder not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/reminders/{reminder_id} [delete]
+func HandleDeleteReminders(handler DeleteRemindersHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &ReminderRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Reminder deleted successfully")
+	}
+}
+
+// Get Deliveries godoc
+//
+//	@Summary	Get the webhook deliveries of a reminder
+//	@Tags		Reminder
+//	@Produce	json
+//	@Param		task_id		path	string	true	"Task ID"
+//	@Param		reminder_id	path	string	true	"Reminder ID"
+//	@Success	200		{object}	ListofDeliveries		"Successfully fetched all deliveries"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Reminder not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{task_id}/reminders/{reminder_id}/deliveries [get]
+func HandleGetDeliveries(handler GetDeliveriesHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &ReminderRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "All deliveries fetched successfully")
+	}
+}
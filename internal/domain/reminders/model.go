@@ -0,0 +1,44 @@
+package reminders
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Reminders struct {
+	ReminderID    int64        `db:"reminder_id"`
+	TaskID        int64        `db:"task_id"`
+	OffsetMinutes int64        `db:"offset_minutes"`
+	RemindAt      time.Time    `db:"remind_at"`
+	FiredAt       sql.NullTime `db:"fired_at"`
+	CreatedAt     time.Time    `db:"created_at"`
+}
+
+// DueReminders is a reminder whose time has come, with the task it is about.
+type DueReminders struct {
+	ReminderID    int64     `db:"reminder_id"`
+	OffsetMinutes int64     `db:"offset_minutes"`
+	TaskID        int64     `db:"task_id"`
+	Title         string    `db:"title"`
+	BrandID       int64     `db:"brand_id"`
+	Brand         string    `db:"brand"`
+	PlatformID    int64     `db:"platform_id"`
+	Platform      string    `db:"platform"`
+	DueDate       time.Time `db:"due_date"`
+	Payment       string    `db:"payment"`
+	Status        string    `db:"status"`
+}
+
+type Deliveries struct {
+	DeliveryID     int64         `db:"delivery_id"`
+	ReminderID     int64         `db:"reminder_id"`
+	URL            string        `db:"url"`
+	Payload        []byte        `db:"payload"`
+	Status         string        `db:"status"`
+	Attempts       int           `db:"attempts"`
+	NextAttemptAt  sql.NullTime  `db:"next_attempt_at"`
+	LastStatusCode sql.NullInt64 `db:"last_status_code"`
+	LastError      string        `db:"last_error"`
+	DeliveredAt    sql.NullTime  `db:"delivered_at"`
+	CreatedAt      time.Time     `db:"created_at"`
+}
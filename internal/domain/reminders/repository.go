@@ -0,0 +1,248 @@
+package reminders
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/jmoiron/sqlx"
+)
+
+var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+type RemindersRepository interface {
+	GetAll(context.Context, *ReminderListParams) ([]*Reminders, error)
+	Add(context.Context, *ReminderListParams, int64) error
+	Delete(context.Context, *ReminderRequestParams) error
+	GetDeliveries(context.Context, *ReminderRequestParams) ([]*Deliveries, error)
+	FireDue(context.Context, []string, int, func(*DueReminders) ([]byte, error)) (int, error)
+	ClaimDeliveries(context.Context, int, time.Duration) ([]*Deliveries, error)
+	RecordAttempt(context.Context, *Deliveries) error
+}
+
+type remindersRepository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) RemindersRepository {
+	return &remindersRepository{
+		db: db,
+	}
+}
+
+func (r *remindersRepository) GetAll(ctx context.Context, params *ReminderListParams) (resp []*Reminders, err error) {
+	stmt, args, _ := pgSquirell.Select("r.reminder_id", "r.task_id", "r.offset_minutes", "t.due_date - make_interval(mins => r.offset_minutes) AS remind_at", "r.fired_at", "r.created_at").
+						From("task_reminders r").
+						Join("tasks t on r.task_id=t.task_id").
+						Where(squirrel.And{squirrel.Eq{"r.deleted_at": nil}, squirrel.Eq{"r.task_id": params.TaskID}}).
+						OrderBy("r.offset_minutes DESC").
+						ToSql()
+
+	resp = []*Reminders{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (r *remindersRepository) Add(ctx context.Context, params *ReminderListParams, offsetMinutes int64) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	var count int64
+
+	stmt, args, _ := pgSquirell.Select("count(*)").From("task_reminders").Where(squirrel.Eq{"task_id": params.TaskID, "offset_minutes": offsetMinutes, "deleted_at": nil}).ToSql()
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return err
+	} else if count > 0 {
+		return exceptions.NewInvariantError("the task already has a reminder with this offset")
+	}
+
+	stmt, args, _ = pgSquirell.Insert("task_reminders").Columns("task_id", "offset_minutes").Values(params.TaskID, offsetMinutes).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *remindersRepository) Delete(ctx context.Context, params *ReminderRequestParams) (err error) {
+	stmt, args, _ := pgSquirell.Update("task_reminders").SetMap(map[string]interface{}{
+		"deleted_at": squirrel.Expr("NOW()"),
+	}).Where(squirrel.Eq{"reminder_id": params.ReminderID, "task_id": params.TaskID, "deleted_at": nil}).ToSql()
+
+	result, err := r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("reminders not found")
+	}
+
+	return nil
+}
+
+func (r *remindersRepository) GetDeliveries(ctx context.Context, params *ReminderRequestParams) (resp []*Deliveries, err error) {
+	resp = []*Deliveries{}
+
+	var count int64
+
+	stmt, args, _ := pgSquirell.Select("count(*)").From("task_reminders").Where(squirrel.Eq{"reminder_id": params.ReminderID, "task_id": params.TaskID, "deleted_at": nil}).ToSql()
+	err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return resp, err
+	} else if count == 0 {
+		return nil, exceptions.NewNotFoundError("reminders not found")
+	}
+
+	stmt, args, _ = pgSquirell.Select("delivery_id", "reminder_id", "url", "payload", "status", "attempts", "next_attempt_at", "last_status_code", "last_error", "delivered_at", "created_at").
+						From("reminder_deliveries").
+						Where(squirrel.Eq{"reminder_id": params.ReminderID}).
+						OrderBy("created_at DESC", "delivery_id DESC").
+						ToSql()
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// FireDue queues a delivery to every url for up to limit reminders whose time
+// has come, building the payload once per reminder. A reminder fires once per
+// due date, so rescheduling a task arms its reminders again. Reminders of
+// completed tasks and of tasks already past due are skipped.
+func (r *remindersRepository) FireDue(ctx context.Context, urls []string, limit int, payload func(*DueReminders) ([]byte, error)) (fired int, err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer tx.Rollback()
+
+	stmt, args, _ := pgSquirell.Select("r.reminder_id", "r.offset_minutes", "t.task_id", "t.title", "t.brand_id", "b.brand", "t.platform_id", "p.platform", "t.due_date", "t.payment", "t.status").
+						From("task_reminders r").
+						Join("tasks t on r.task_id=t.task_id").
+						LeftJoin("brands b on t.brand_id=b.brand_id").
+						LeftJoin("platforms p on t.platform_id=p.platform_id").
+						Where(squirrel.And{
+							squirrel.Eq{"r.deleted_at": nil, "t.deleted_at": nil, "b.deleted_at": nil, "p.deleted_at": nil},
+							squirrel.NotEq{"t.status": "Completed"},
+							squirrel.Expr("r.fired_due_date IS DISTINCT FROM t.due_date"),
+							squirrel.Expr("t.due_date - make_interval(mins => r.offset_minutes) <= NOW()"),
+							squirrel.Expr("t.due_date > NOW()"),
+						}).
+						OrderBy("r.reminder_id ASC").
+						Limit(uint64(limit)).
+						Suffix("FOR UPDATE OF r SKIP LOCKED").
+						ToSql()
+
+	due := []*DueReminders{}
+	if err = tx.SelectContext(ctx, &due, stmt, args...); err != nil {
+		return 0, err
+	}
+
+	for _, reminder := range due {
+		body, err := payload(reminder)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, url := range urls {
+			stmt, args, _ = pgSquirell.Insert("reminder_deliveries").Columns("reminder_id", "url", "payload").Values(reminder.ReminderID, url, string(body)).ToSql()
+
+			if _, err = tx.ExecContext(ctx, stmt, args...); err != nil {
+				return 0, err
+			}
+		}
+
+		stmt, args, _ = pgSquirell.Update("task_reminders").SetMap(map[string]interface{}{
+			"fired_due_date": reminder.DueDate,
+			"fired_at":       squirrel.Expr("NOW()"),
+			"updated_at":     squirrel.Expr("NOW()"),
+		}).Where(squirrel.Eq{"reminder_id": reminder.ReminderID}).ToSql()
+
+		if _, err = tx.ExecContext(ctx, stmt, args...); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return len(due), nil
+}
+
+// ClaimDeliveries picks up to limit pending deliveries that are ready to be
+// attempted and leases them, pushing next_attempt_at past the lease so no
+// other server sends them meanwhile. If the sender dies the lease simply runs
+// out and the delivery is retried.
+func (r *remindersRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) (resp []*Deliveries, err error) {
+	ready := squirrel.Select("delivery_id").
+				From("reminder_deliveries").
+				Where(squirrel.And{squirrel.Eq{"status": DeliveryPending}, squirrel.Expr("next_attempt_at <= NOW()")}).
+				OrderBy("next_attempt_at ASC").
+				Limit(uint64(limit)).
+				Suffix("FOR UPDATE SKIP LOCKED")
+
+	stmt, args, _ := pgSquirell.Update("reminder_deliveries").SetMap(map[string]interface{}{
+		"next_attempt_at": squirrel.Expr("NOW() + make_interval(secs => ?)", lease.Seconds()),
+		"updated_at":      squirrel.Expr("NOW()"),
+	}).Where(squirrel.Expr("delivery_id IN (?)", ready)).
+		Suffix("RETURNING delivery_id, reminder_id, url, payload, status, attempts, next_attempt_at, last_status_code, last_error, delivered_at, created_at").
+		ToSql()
+
+	resp = []*Deliveries{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// RecordAttempt stores the outcome of an attempt to send a delivery.
+func (r *remindersRepository) RecordAttempt(ctx context.Context, delivery *Deliveries) (err error) {
+	setMap := map[string]interface{}{
+		"status":           delivery.Status,
+		"attempts":         delivery.Attempts,
+		"last_status_code": delivery.LastStatusCode,
+		"last_error":       delivery.LastError,
+		"next_attempt_at":  delivery.NextAttemptAt,
+		"updated_at":       squirrel.Expr("NOW()"),
+	}
+
+	if delivery.Status == DeliverySucceeded {
+		setMap["delivered_at"] = squirrel.Expr("NOW()")
+	}
+
+	stmt, args, _ := pgSquirell.Update("reminder_deliveries").SetMap(setMap).Where(squirrel.Eq{"delivery_id": delivery.DeliveryID}).ToSql()
+
+	_, err = r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
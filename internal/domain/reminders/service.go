@@ -0,0 +1,266 @@
+package reminders
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/agungramananda/sosmed-todolist/internal/common/webhook"
+)
+
+const (
+	// maxOffset is how long before the due date a reminder may fire at most.
+	maxOffset = 90 * 24 * time.Hour
+
+	fireBatchSize    = 100
+	deliverBatchSize = 20
+
+	// deliveryLease must outlast sending a whole batch with the client timeout.
+	deliveryLease = 5 * time.Minute
+
+	maxDeliveryAttempts = 6
+	retryBaseDelay      = 30 * time.Second
+	retryMaxDelay       = time.Hour
+)
+
+type RemindersService interface {
+	GetAll(context.Context, *ReminderListParams) (*ListofReminders, error)
+	Create(context.Context, *ReminderListParams, *ReminderRequestPayload) error
+	Delete(context.Context, *ReminderRequestParams) error
+	GetDeliveries(context.Context, *ReminderRequestParams) (*ListofDeliveries, error)
+	Dispatch(context.Context) error
+}
+
+type remindersService struct {
+	repo       RemindersRepository
+	taskOwners auth.TaskOwners
+	client     *webhook.Client
+	urls       []string
+}
+
+func NewService(r RemindersRepository, taskOwners auth.TaskOwners, client *webhook.Client, urls []string) *remindersService {
+	return &remindersService{repo: r, taskOwners: taskOwners, client: client, urls: urls}
+}
+
+func (svc *remindersService) GetAll(ctx context.Context, params *ReminderListParams) (listOfReminders *ListofReminders, err error) {
+	if _, err = auth.ReadTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return listOfReminders, err
+	}
+
+	reminders, err := svc.repo.GetAll(ctx, params)
+	if err != nil {
+		return listOfReminders, err
+	}
+
+	listOfReminders = &ListofReminders{
+		Reminders: []*ReminderDetails{},
+	}
+
+	for _, reminder := range reminders {
+		details := &ReminderDetails{
+			ReminderID:    reminder.ReminderID,
+			TaskID:        reminder.TaskID,
+			Offset:        formatOffset(reminder.OffsetMinutes),
+			OffsetMinutes: reminder.OffsetMinutes,
+			RemindAt:      reminder.RemindAt.Format(time.RFC3339),
+			CreatedAt:     reminder.CreatedAt.Format(time.RFC3339),
+		}
+		details.FiredAt = formatNullTime(reminder.FiredAt)
+
+		listOfReminders.Reminders = append(listOfReminders.Reminders, details)
+	}
+
+	return listOfReminders, nil
+}
+
+func (svc *remindersService) Create(ctx context.Context, params *ReminderListParams, payload *ReminderRequestPayload) (err error) {
+	if _, err = auth.EditTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return err
+	}
+
+	offset, err := parseOffset(payload.Offset)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Add(ctx, params, int64(offset/time.Minute))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *remindersService) Delete(ctx context.Context, params *ReminderRequestParams) (err error) {
+	if _, err = auth.EditTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return err
+	}
+
+	err = svc.repo.Delete(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *remindersService) GetDeliveries(ctx context.Context, params *ReminderRequestParams) (listOfDeliveries *ListofDeliveries, err error) {
+	if _, err = auth.ReadTask(ctx, svc.taskOwners, params.TaskID); err != nil {
+		return listOfDeliveries, err
+	}
+
+	deliveries, err := svc.repo.GetDeliveries(ctx, params)
+	if err != nil {
+		return listOfDeliveries, err
+	}
+
+	listOfDeliveries = &ListofDeliveries{
+		Deliveries: []*DeliveryDetails{},
+	}
+
+	for _, delivery := range deliveries {
+		details := &DeliveryDetails{
+			DeliveryID: delivery.DeliveryID,
+			ReminderID: delivery.ReminderID,
+			URL:        delivery.URL,
+			Status:     delivery.Status,
+			Attempts:   delivery.Attempts,
+			LastError:  delivery.LastError,
+			CreatedAt:  delivery.CreatedAt.Format(time.RFC3339),
+		}
+		if delivery.LastStatusCode.Valid {
+			details.LastStatusCode = &delivery.LastStatusCode.Int64
+		}
+		if delivery.Status == DeliveryPending {
+			details.NextAttemptAt = formatNullTime(delivery.NextAttemptAt)
+		}
+		details.DeliveredAt = formatNullTime(delivery.DeliveredAt)
+
+		listOfDeliveries.Deliveries = append(listOfDeliveries.Deliveries, details)
+	}
+
+	return listOfDeliveries, nil
+}
+
+// Dispatch queues the deliveries of the reminders that are due and sends the
+// deliveries that are ready. It is run periodically in the background and
+// does nothing while no webhook URL is configured.
+func (svc *remindersService) Dispatch(ctx context.Context) (err error) {
+	if len(svc.urls) == 0 {
+		return nil
+	}
+
+	if _, err = svc.repo.FireDue(ctx, svc.urls, fireBatchSize, reminderPayload); err != nil {
+		return err
+	}
+
+	deliveries, err := svc.repo.ClaimDeliveries(ctx, deliverBatchSize, deliveryLease)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		if err = svc.deliver(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliver makes one attempt to send a delivery and records the outcome. A
+// failed attempt is retried with exponential backoff until the attempts run
+// out.
+func (svc *remindersService) deliver(ctx context.Context, delivery *Deliveries) error {
+	header := http.Header{}
+	header.Set("X-Delivery-ID", strconv.FormatInt(delivery.DeliveryID, 10))
+	header.Set("X-Delivery-Attempt", strconv.Itoa(delivery.Attempts+1))
+
+	statusCode, err := svc.client.Post(ctx, delivery.URL, delivery.Payload, header)
+
+	// the server is shutting down, the lease runs out and the attempt is
+	// made again later without counting this one
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	delivery.Attempts++
+	delivery.LastStatusCode = sql.NullInt64{Int64: int64(statusCode), Valid: statusCode != 0}
+	delivery.LastError = ""
+	delivery.NextAttemptAt = sql.NullTime{}
+
+	switch {
+	case err == nil:
+		delivery.Status = DeliverySucceeded
+	case delivery.Attempts >= maxDeliveryAttempts:
+		delivery.Status = DeliveryFailed
+		delivery.LastError = err.Error()
+	default:
+		delivery.Status = DeliveryPending
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = sql.NullTime{Time: time.Now().Add(webhook.Backoff(delivery.Attempts, retryBaseDelay, retryMaxDelay)), Valid: true}
+	}
+
+	return svc.repo.RecordAttempt(ctx, delivery)
+}
+
+func reminderPayload(reminder *DueReminders) ([]byte, error) {
+	return json.Marshal(&ReminderWebhookPayload{
+		Event:   "task.reminder",
+		FiredAt: time.Now().UTC().Format(time.RFC3339),
+		Reminder: ReminderWebhookReminder{
+			ReminderID:    reminder.ReminderID,
+			Offset:        formatOffset(reminder.OffsetMinutes),
+			OffsetMinutes: reminder.OffsetMinutes,
+		},
+		Task: ReminderWebhookTask{
+			TaskID:     reminder.TaskID,
+			Title:      reminder.Title,
+			BrandID:    reminder.BrandID,
+			Brand:      reminder.Brand,
+			PlatformID: reminder.PlatformID,
+			Platform:   reminder.Platform,
+			DueDate:    reminder.DueDate.Format("2006-01-02"),
+			Payment:    reminder.Payment,
+			Status:     reminder.Status,
+		},
+	})
+}
+
+// parseOffset reads offsets such as "24h", "90m" or "2h before".
+func parseOffset(value string) (time.Duration, error) {
+	value = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(strings.ToLower(value)), "before"))
+
+	offset, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, exceptions.NewInvariantError("offset must be a duration such as 24h or 90m")
+	}
+	if offset < time.Minute || offset > maxOffset {
+		return 0, exceptions.NewInvariantError(fmt.Sprintf("offset must be between 1m and %s", formatOffset(int64(maxOffset/time.Minute))))
+	}
+	if offset%time.Minute != 0 {
+		return 0, exceptions.NewInvariantError("offset must be a whole number of minutes")
+	}
+
+	return offset, nil
+}
+
+func formatOffset(minutes int64) string {
+	return (time.Duration(minutes) * time.Minute).String()
+}
+
+func formatNullTime(value sql.NullTime) *string {
+	if !value.Valid {
+		return nil
+	}
+
+	formatted := value.Time.Format(time.RFC3339)
+	return &formatted
+}
@@ -0,0 +1,164 @@
+package reminders
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/webhook"
+)
+
+// fakeRepository hands out the deliveries of a test and keeps the attempts
+// recorded for them. Methods Dispatch does not use are left to the embedded
+// interface.
+type fakeRepository struct {
+	RemindersRepository
+	deliveries []*Deliveries
+	recorded   []Deliveries
+}
+
+func (r *fakeRepository) FireDue(context.Context, []string, int, func(*DueReminders) ([]byte, error)) (int, error) {
+	return 0, nil
+}
+
+func (r *fakeRepository) ClaimDeliveries(context.Context, int, time.Duration) ([]*Deliveries, error) {
+	return r.deliveries, nil
+}
+
+func (r *fakeRepository) RecordAttempt(_ context.Context, delivery *Deliveries) error {
+	r.recorded = append(r.recorded, *delivery)
+	return nil
+}
+
+// standIn is a local webhook endpoint answering every request with status.
+func standIn(t *testing.T, status int, requests *[]*http.Request) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestDispatchDelivers(t *testing.T) {
+	requests := []*http.Request{}
+	server := standIn(t, http.StatusOK, &requests)
+
+	repo := &fakeRepository{deliveries: []*Deliveries{{DeliveryID: 7, URL: server.URL, Payload: []byte(`{}`), Status: DeliveryPending}}}
+	svc := NewService(repo, nil, webhook.NewClient(time.Second), []string{server.URL})
+
+	if err := svc.Dispatch(context.Background()); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+
+	if len(requests) != 1 {
+		t.Fatalf("endpoint got %d requests, want 1", len(requests))
+	}
+	if got := requests[0].Header.Get("X-Delivery-ID"); got != "7" {
+		t.Errorf("X-Delivery-ID = %q, want 7", got)
+	}
+	if got := requests[0].Header.Get("X-Delivery-Attempt"); got != "1" {
+		t.Errorf("X-Delivery-Attempt = %q, want 1", got)
+	}
+
+	if len(repo.recorded) != 1 {
+		t.Fatalf("recorded %d attempts, want 1", len(repo.recorded))
+	}
+	delivery := repo.recorded[0]
+	if delivery.Status != DeliverySucceeded || delivery.Attempts != 1 {
+		t.Errorf("delivery is %s after %d attempts, want %s after 1", delivery.Status, delivery.Attempts, DeliverySucceeded)
+	}
+	if delivery.LastStatusCode.Int64 != http.StatusOK || delivery.NextAttemptAt.Valid {
+		t.Errorf("delivery has status code %d and next attempt %v, want 200 and none", delivery.LastStatusCode.Int64, delivery.NextAttemptAt)
+	}
+}
+
+func TestDeliverRetriesWithBackoff(t *testing.T) {
+	requests := []*http.Request{}
+	server := standIn(t, http.StatusServiceUnavailable, &requests)
+
+	repo := &fakeRepository{}
+	svc := NewService(repo, nil, webhook.NewClient(time.Second), []string{server.URL})
+	delivery := &Deliveries{DeliveryID: 1, URL: server.URL, Payload: []byte(`{}`), Status: DeliveryPending}
+
+	delays := []time.Duration{}
+	for attempt := 1; attempt <= 3; attempt++ {
+		before := time.Now()
+		if err := svc.deliver(context.Background(), delivery); err != nil {
+			t.Fatalf("deliver() error = %v", err)
+		}
+
+		recorded := repo.recorded[len(repo.recorded)-1]
+		if recorded.Status != DeliveryPending || recorded.Attempts != attempt {
+			t.Fatalf("delivery is %s after %d attempts, want %s after %d", recorded.Status, recorded.Attempts, DeliveryPending, attempt)
+		}
+		if recorded.LastStatusCode.Int64 != http.StatusServiceUnavailable || recorded.LastError == "" {
+			t.Errorf("attempt %d recorded status code %d and error %q", attempt, recorded.LastStatusCode.Int64, recorded.LastError)
+		}
+		if !recorded.NextAttemptAt.Valid {
+			t.Fatalf("attempt %d has no next attempt", attempt)
+		}
+
+		delay := recorded.NextAttemptAt.Time.Sub(before)
+		if want := webhook.Backoff(attempt, retryBaseDelay, retryMaxDelay); delay < want || delay > want+time.Second {
+			t.Errorf("attempt %d is retried in %s, want about %s", attempt, delay, want)
+		}
+		delays = append(delays, delay)
+	}
+
+	for i := 1; i < len(delays); i++ {
+		if delays[i] <= delays[i-1] {
+			t.Errorf("retry delays %v do not grow", delays)
+		}
+	}
+
+	if got := requests[2].Header.Get("X-Delivery-Attempt"); got != "3" {
+		t.Errorf("third request has X-Delivery-Attempt %q, want 3", got)
+	}
+}
+
+func TestDeliverFailsAfterMaxAttempts(t *testing.T) {
+	requests := []*http.Request{}
+	server := standIn(t, http.StatusInternalServerError, &requests)
+
+	repo := &fakeRepository{}
+	svc := NewService(repo, nil, webhook.NewClient(time.Second), []string{server.URL})
+	delivery := &Deliveries{DeliveryID: 1, URL: server.URL, Payload: []byte(`{}`), Status: DeliveryPending, Attempts: maxDeliveryAttempts - 1}
+
+	if err := svc.deliver(context.Background(), delivery); err != nil {
+		t.Fatalf("deliver() error = %v", err)
+	}
+
+	recorded := repo.recorded[0]
+	if recorded.Status != DeliveryFailed || recorded.Attempts != maxDeliveryAttempts {
+		t.Errorf("delivery is %s after %d attempts, want %s after %d", recorded.Status, recorded.Attempts, DeliveryFailed, maxDeliveryAttempts)
+	}
+	if recorded.NextAttemptAt.Valid || recorded.LastError == "" {
+		t.Errorf("failed delivery has next attempt %v and error %q, want none and an error", recorded.NextAttemptAt, recorded.LastError)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{7, 32 * time.Minute},
+		{8, time.Hour},
+		{20, time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := webhook.Backoff(tt.attempts, retryBaseDelay, retryMaxDelay); got != tt.want {
+			t.Errorf("Backoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package series
+
+import (
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type SeriesController struct {
+	svc SeriesService
+}
+
+func NewController(svc SeriesService) *SeriesController {
+	return &SeriesController{
+		svc: svc,
+	}
+}
+
+const (
+	seriesBasepath = "/series"
+)
+
+func (con *SeriesController) Route(grp *echo.Group){
+	subrouter := grp.Group(seriesBasepath, middleware.RequireScopes(auth.ScopeTasksRead, auth.ScopeTasksWrite))
+
+	subrouter.GET("", HandleGetAllSeries(con.svc.GetAll))
+	subrouter.GET("/:series_id", HandleGetOneSeries(con.svc.GetOne))
+	subrouter.POST("", HandleCreateSeries(con.svc.Create))
+	subrouter.PUT("/:series_id", HandleUpdateSeries(con.svc.Update))
+	subrouter.DELETE("/:series_id", HandleDeleteSeries(con.svc.Delete))
+}
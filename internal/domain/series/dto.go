@@ -0,0 +1,59 @@
+package series
+
+import "github.com/agungramananda/sosmed-todolist/internal/common/httpres"
+
+const (
+	ScopeThis   = "this"
+	ScopeFuture = "future"
+)
+
+type SeriesRequestParams struct {
+	SeriesID string `param:"series_id" validate:"required"`
+}
+
+type SeriesRequestPayload struct {
+	Title      string   `json:"title" validate:"required"`
+	BrandID    int64    `json:"brand_id" validate:"required,min=1"`
+	PlatformID int64    `json:"platform_id" validate:"required,min=1"`
+	Payment    int64    `json:"payment" validate:"required,min=1"`
+	Caption    string   `json:"caption" validate:"omitempty,max=5000"`
+	Hashtags   []string `json:"hashtags" validate:"omitempty,max=30,dive,max=100"`
+	CTAURL     string   `json:"cta_url" validate:"omitempty,url,max=2048"`
+	RRule      string   `json:"rrule" validate:"required,max=512" example:"FREQ=WEEKLY;BYDAY=MO,WE,FR"`
+	StartDate  string   `json:"start_date" validate:"required,datetime=2006-01-02"`
+}
+
+// SeriesOccurrenceQuery picks which occurrences an edit or cancellation
+// applies to: only the one on Occurrence, or it and every later one.
+type SeriesOccurrenceQuery struct {
+	Scope      string `query:"scope" validate:"required,oneof=this future"`
+	Occurrence string `query:"occurrence" validate:"required,datetime=2006-01-02"`
+}
+
+type SeriesRequestQuery struct {
+	Keyword string `query:"keyword" validate:"omitempty,max=100"`
+	Limit   uint64 `query:"limit" validate:"omitempty,min=1,max=100"`
+	Page    uint64 `query:"page" validate:"omitempty,min=1"`
+}
+
+type SeriesDetails struct {
+	SeriesID          int64    `json:"series_id"`
+	Title             string   `json:"title"`
+	BrandID           int64    `json:"brand_id"`
+	Brand             string   `json:"brand"`
+	PlatformID        int64    `json:"platform_id"`
+	Platform          string   `json:"platform"`
+	Payment           string   `json:"payment"`
+	Caption           string   `json:"caption"`
+	Hashtags          []string `json:"hashtags"`
+	CTAURL            string   `json:"cta_url"`
+	RRule             string   `json:"rrule" example:"FREQ=WEEKLY;BYDAY=MO,WE,FR"`
+	StartDate         string   `json:"start_date" example:"2026-11-02"`
+	UntilDate         *string  `json:"until_date" example:"2026-12-31"`
+	MaterializedUntil *string  `json:"materialized_until" example:"2026-12-28"`
+}
+
+type ListofSeries struct {
+	Series []*SeriesDetails       `json:"series"`
+	Meta   httpres.ListPagination `json:"meta"`
+}
@@ -0,0 +1,217 @@
+package series
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type GetAllSeriesHandler func(context.Context, *SeriesRequestQuery) (*ListofSeries, error)
+type GetOneSeriesHandler func(context.Context, *SeriesRequestParams) (*SeriesDetails, error)
+type CreateSeriesHandler func(context.Context, *SeriesRequestPayload) error
+type UpdateSeriesHandler func(context.Context, *SeriesRequestParams, *SeriesOccurrenceQuery, *SeriesRequestPayload) error
+type DeleteSeriesHandler func(context.Context, *SeriesRequestParams, *SeriesOccurrenceQuery) error
+
+// Get All Series godoc
+//
+//	@Summary	Get all recurring task series
+//	@Tags		Series
+//	@Produce	json
+//	@Param		keyword	query		string	false	"Keyword to search"
+//	@Param		limit	query		int		false	"Number of entities per page"
+//	@Param		page	query		int		false	"Page number"
+//	@Success	200		{object}	ListofSeries	"Successfully fetched all series"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/series [get]
+func HandleGetAllSeries(handler GetAllSeriesHandler) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		ctx := c.Request().Context()
+		query := &SeriesRequestQuery{}
+
+		if err = c.Bind(query); err != nil {
+			return err
+		}
+
+		if err = c.Validate(query); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "All series fetched successfully")
+	}
+}
+
+// Get One Series godoc
+//
+//	@Summary	Get a single recurring task series by ID
+//	@Tags		Series
+//	@Produce	json
+//	@Param		id	path	string	true	"Series ID"
+//	@Success	200		{object}	SeriesDetails	"Successfully fetched the series"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Series not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/series/{id} [get]
+func HandleGetOneSeries(handler GetOneSeriesHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &SeriesRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "Series fetched successfully")
+	}
+}
+
+// Create Series godoc
+//
+//	@Summary		Create a recurring task series
+//	@Description	The rrule follows RFC 5545 (FREQ=DAILY|WEEKLY|MONTHLY with INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL). Tasks are created for the occurrences of the next 8 weeks and the horizon moves forward every hour.
+//	@Tags			Series
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body	SeriesRequestPayload	true	"Series details"
+//	@Success		201		{object}	httpres.BaseResponse	"Series successfully created"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Role may not create tasks"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/series [post]
+func HandleCreateSeries(handler CreateSeriesHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		payload := &SeriesRequestPayload{}
+
+		if err := c.Bind(payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusCreated, nil, "New series successfully added")
+	}
+}
+
+// Update Series godoc
+//
+//	@Summary		Update one occurrence or all future occurrences of a series
+//	@Description	With scope=this only the task of the occurrence is changed and rrule and start_date are ignored. With scope=future the series is split, the occurrence and every later one follow the new details and rrule starting at the occurrence, open tasks already created for them are replaced and completed ones are kept.
+//	@Tags			Series
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path	string					true	"Series ID"
+//	@Param			scope		query	string					true	"Occurrences to change"	Enums(this, future)
+//	@Param			occurrence	query	string					true	"Occurrence date (YYYY-MM-DD)"
+//	@Param			body		body	SeriesRequestPayload	true	"Updated series details"
+//	@Success		200		{object}	httpres.BaseResponse	"Series updated successfully"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Role may not change the series"
+//	@Failure		404		{object}	httpres.ErrorResponse	"Series not found"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/series/{id} [put]
+func HandleUpdateSeries(handler UpdateSeriesHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &SeriesRequestParams{}
+		query := &SeriesOccurrenceQuery{}
+		payload := &SeriesRequestPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindQueryParams(c, query); err != nil {
+			return err
+		}
+
+		if err := c.Validate(query); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, query, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Series updated successfully")
+	}
+}
+
+// Delete Series godoc
+//
+//	@Summary		Cancel one occurrence or all future occurrences of a series
+//	@Description	A cancelled occurrence is never created again. Completed tasks are kept when future occurrences are cancelled.
+//	@Tags			Series
+//	@Produce		json
+//	@Param			id			path	string	true	"Series ID"
+//	@Param			scope		query	string	true	"Occurrences to cancel"	Enums(this, future)
+//	@Param			occurrence	query	string	true	"Occurrence date (YYYY-MM-DD)"
+//	@Success		200		{object}	httpres.BaseResponse	"Series occurrences cancelled successfully"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Role may not change the series"
+//	@Failure		404		{object}	httpres.ErrorResponse	"Series not found"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/series/{id} [delete]
+func HandleDeleteSeries(handler DeleteSeriesHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &SeriesRequestParams{}
+		query := &SeriesOccurrenceQuery{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindQueryParams(c, query); err != nil {
+			return err
+		}
+
+		if err := c.Validate(query); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, query); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Series occurrences cancelled successfully")
+	}
+}
@@ -0,0 +1,26 @@
+package series
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+)
+
+type Series struct {
+	SeriesID          int64             `db:"series_id"`
+	WorkspaceID       int64             `db:"workspace_id"`
+	Title             string            `db:"title"`
+	BrandID           int64             `db:"brand_id"`
+	Brand             string            `db:"brand"`
+	PlatformID        int64             `db:"platform_id"`
+	Platform          string            `db:"platform"`
+	Payment           string            `db:"payment"`
+	Caption           string            `db:"caption"`
+	Hashtags          utils.StringArray `db:"hashtags"`
+	CTAURL            string            `db:"cta_url"`
+	RRule             string            `db:"rrule"`
+	StartDate         time.Time         `db:"start_date"`
+	UntilDate         sql.NullTime      `db:"until_date"`
+	MaterializedUntil sql.NullTime      `db:"materialized_until"`
+}
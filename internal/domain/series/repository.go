@@ -0,0 +1,438 @@
+package series
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/jmoiron/sqlx"
+)
+
+var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+type SeriesRepository interface {
+	GetAll(context.Context, int64, *SeriesRequestQuery) ([]*Series, error)
+	Count(context.Context, int64, *SeriesRequestQuery) (uint64, error)
+	GetByID(context.Context, int64, *SeriesRequestParams) (*Series, error)
+	GetPending(context.Context) ([]*Series, error)
+	Add(context.Context, int64, *SeriesRequestPayload) (int64, error)
+	Materialize(context.Context, *Series, []time.Time, time.Time) error
+	UpdateOccurrence(context.Context, *Series, time.Time, *SeriesRequestPayload) error
+	CancelOccurrence(context.Context, *Series, time.Time) error
+	Split(context.Context, *Series, time.Time, *SeriesRequestPayload) (int64, error)
+	CancelFuture(context.Context, *Series, time.Time) error
+}
+
+type seriesRepository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) SeriesRepository {
+	return &seriesRepository{
+		db: db,
+	}
+}
+
+func selectSeries() squirrel.SelectBuilder {
+	return pgSquirell.Select("s.series_id", "s.workspace_id", "s.title", "s.brand_id", "b.brand", "s.platform_id", "p.platform", "s.payment", "s.caption", "s.hashtags", "s.cta_url", "s.rrule", "s.start_date", "s.until_date", "s.materialized_until").
+		From("task_series s").
+		LeftJoin("brands b on s.brand_id=b.brand_id").
+		LeftJoin("platforms p on s.platform_id=p.platform_id").
+		Where(squirrel.And{squirrel.Eq{"s.deleted_at": nil}, squirrel.Eq{"b.deleted_at": nil}, squirrel.Eq{"p.deleted_at": nil}})
+}
+
+func (r *seriesRepository) GetAll(ctx context.Context, workspaceID int64, query *SeriesRequestQuery) (resp []*Series, err error) {
+	keyword := query.Keyword
+	utils.KeywordHelper(&keyword)
+
+	stmt, args, _ := selectSeries().
+						Where(squirrel.And{squirrel.Eq{"s.workspace_id": workspaceID}, squirrel.ILike{"s.title": keyword}}).
+						OrderBy("s.series_id ASC").
+						Limit(query.Limit).Offset((query.Page - 1) * query.Limit).ToSql()
+
+	resp = []*Series{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (r *seriesRepository) Count(ctx context.Context, workspaceID int64, query *SeriesRequestQuery) (resp uint64, err error) {
+	keyword := query.Keyword
+	utils.KeywordHelper(&keyword)
+
+	stmt, args, _ := pgSquirell.Select("count(s.series_id)").
+						From("task_series s").
+						LeftJoin("brands b on s.brand_id=b.brand_id").
+						LeftJoin("platforms p on s.platform_id=p.platform_id").
+						Where(squirrel.And{squirrel.Eq{"s.deleted_at": nil, "s.workspace_id": workspaceID}, squirrel.Eq{"b.deleted_at": nil}, squirrel.Eq{"p.deleted_at": nil}, squirrel.ILike{"s.title": keyword}}).
+						ToSql()
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&resp)
+	if err != nil && err != sql.ErrNoRows {
+		return resp, err
+	} else if err == sql.ErrNoRows {
+		return 0, nil
+	}
+
+	return resp, nil
+}
+
+func (r *seriesRepository) GetByID(ctx context.Context, workspaceID int64, params *SeriesRequestParams) (resp *Series, err error) {
+	stmt, args, _ := selectSeries().Where(squirrel.Eq{"s.series_id": params.SeriesID, "s.workspace_id": workspaceID}).ToSql()
+
+	resp = &Series{}
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).StructScan(resp)
+	if err != nil && err != sql.ErrNoRows {
+		return resp, err
+	} else if err == sql.ErrNoRows {
+		return nil, exceptions.NewNotFoundError("series not found")
+	}
+
+	return resp, nil
+}
+
+// GetPending returns the series that may still have occurrences to
+// materialise, i.e. those not yet materialised up to their end date.
+func (r *seriesRepository) GetPending(ctx context.Context) (resp []*Series, err error) {
+	stmt, args, _ := selectSeries().
+						Where(squirrel.Or{squirrel.Eq{"s.until_date": nil}, squirrel.Eq{"s.materialized_until": nil}, squirrel.Expr("s.materialized_until < s.until_date")}).
+						OrderBy("s.series_id ASC").
+						ToSql()
+
+	resp = []*Series{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (r *seriesRepository) Add(ctx context.Context, workspaceID int64, payload *SeriesRequestPayload) (seriesID int64, err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer tx.Rollback()
+
+	if err = checkReferences(ctx, tx, workspaceID, payload); err != nil {
+		return 0, err
+	}
+
+	seriesID, err = insertSeries(ctx, tx, workspaceID, payload, payload.StartDate)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return seriesID, nil
+}
+
+// Materialize inserts a task for each occurrence date that does not have one
+// yet and moves the series' materialized_until mark to through.
+func (r *seriesRepository) Materialize(ctx context.Context, series *Series, dates []time.Time, through time.Time) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	for _, date := range dates {
+		if _, err = insertOccurrence(ctx, tx, series, date, false); err != nil {
+			return err
+		}
+	}
+
+	stmt, args, _ := pgSquirell.Update("task_series").SetMap(map[string]interface{}{
+		"materialized_until": through,
+	}).Where(squirrel.Eq{"series_id": series.SeriesID}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *seriesRepository) UpdateOccurrence(ctx context.Context, series *Series, date time.Time, payload *SeriesRequestPayload) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	if err = checkReferences(ctx, tx, series.WorkspaceID, payload); err != nil {
+		return err
+	}
+
+	exists, err := occurrenceExists(ctx, tx, series, date)
+	if err != nil {
+		return err
+	}
+
+	// an occurrence beyond the materialised horizon gets its task now, the
+	// edit is then applied to it like to any other occurrence
+	if !exists {
+		if _, err = insertOccurrence(ctx, tx, series, date, false); err != nil {
+			return err
+		}
+	}
+
+	stmt, args, _ := pgSquirell.Update("tasks").SetMap(map[string]interface{}{
+		"title":       payload.Title,
+		"brand_id":    payload.BrandID,
+		"platform_id": payload.PlatformID,
+		"payment":     payload.Payment,
+		"caption":     payload.Caption,
+		"hashtags":    payload.Hashtags,
+		"cta_url":     payload.CTAURL,
+		"updated_at":  squirrel.Expr("NOW()"),
+	}).Where(squirrel.Eq{"series_id": series.SeriesID, "occurrence_date": date, "deleted_at": nil}).ToSql()
+
+	result, err := tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("occurrence has been cancelled")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *seriesRepository) CancelOccurrence(ctx context.Context, series *Series, date time.Time) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	exists, err := occurrenceExists(ctx, tx, series, date)
+	if err != nil {
+		return err
+	}
+
+	// keep a deleted task for an occurrence that was not materialised yet, so
+	// the unique (series_id, occurrence_date) index stops it being created
+	if !exists {
+		if _, err = insertOccurrence(ctx, tx, series, date, true); err != nil {
+			return err
+		}
+	} else {
+		stmt, args, _ := pgSquirell.Update("tasks").SetMap(map[string]interface{}{
+			"deleted_at": squirrel.Expr("NOW()"),
+		}).Where(squirrel.Eq{"series_id": series.SeriesID, "occurrence_date": date, "deleted_at": nil}).ToSql()
+
+		result, err := tx.ExecContext(ctx, stmt, args...)
+		if err != nil {
+			return err
+		}
+
+		if affected, err := result.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return exceptions.NewNotFoundError("occurrence has already been cancelled")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Split ends the series before date and starts a new series from date with
+// the payload, which is how "this and all future occurrences" edits work.
+func (r *seriesRepository) Split(ctx context.Context, series *Series, date time.Time, payload *SeriesRequestPayload) (seriesID int64, err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer tx.Rollback()
+
+	if err = checkReferences(ctx, tx, series.WorkspaceID, payload); err != nil {
+		return 0, err
+	}
+
+	if err = endSeries(ctx, tx, series, date); err != nil {
+		return 0, err
+	}
+
+	seriesID, err = insertSeries(ctx, tx, series.WorkspaceID, payload, date.Format("2006-01-02"))
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return seriesID, nil
+}
+
+func (r *seriesRepository) CancelFuture(ctx context.Context, series *Series, date time.Time) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	if err = endSeries(ctx, tx, series, date); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func checkReferences(ctx context.Context, tx *sqlx.Tx, workspaceID int64, payload *SeriesRequestPayload) (err error) {
+	var count int64
+
+	stmt, args, _ := pgSquirell.Select("count(*)").From("brands").Where(squirrel.Eq{"brand_id": payload.BrandID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return err
+	} else if count == 0 {
+		return exceptions.NewInvariantError("brand_id does not exist")
+	}
+
+	stmt, args, _ = pgSquirell.Select("count(*)").From("platforms").Where(squirrel.Eq{"platform_id": payload.PlatformID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return err
+	} else if count == 0 {
+		return exceptions.NewInvariantError("platform_id does not exist")
+	}
+
+	return nil
+}
+
+func insertSeries(ctx context.Context, tx *sqlx.Tx, workspaceID int64, payload *SeriesRequestPayload, startDate string) (seriesID int64, err error) {
+	stmt, args, _ := pgSquirell.Insert("task_series").
+						Columns("workspace_id", "title", "brand_id", "platform_id", "payment", "caption", "hashtags", "cta_url", "rrule", "start_date").
+						Values(workspaceID, payload.Title, payload.BrandID, payload.PlatformID, payload.Payment, payload.Caption, payload.Hashtags, payload.CTAURL, payload.RRule, startDate).
+						Suffix("RETURNING series_id").
+						ToSql()
+
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&seriesID)
+	return seriesID, err
+}
+
+// occurrenceExists reports whether a task row, deleted or not, exists for the
+// occurrence.
+func occurrenceExists(ctx context.Context, tx *sqlx.Tx, series *Series, date time.Time) (bool, error) {
+	var count int64
+
+	stmt, args, _ := pgSquirell.Select("count(*)").From("tasks").Where(squirrel.Eq{"series_id": series.SeriesID, "occurrence_date": date}).ToSql()
+	if err := tx.QueryRowxContext(ctx, stmt, args...).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// insertOccurrence creates the task of one occurrence from the series
+// template unless it already exists. It reports whether a row was inserted.
+func insertOccurrence(ctx context.Context, tx *sqlx.Tx, series *Series, date time.Time, cancelled bool) (bool, error) {
+	columns := []string{"workspace_id", "title", "brand_id", "platform_id", "due_date", "payment", "status", "caption", "hashtags", "cta_url", "series_id", "occurrence_date"}
+	values := []interface{}{series.WorkspaceID, series.Title, series.BrandID, series.PlatformID, date, series.Payment, "Pending", series.Caption, []string(series.Hashtags), series.CTAURL, series.SeriesID, date}
+
+	if cancelled {
+		columns = append(columns, "deleted_at")
+		values = append(values, squirrel.Expr("NOW()"))
+	}
+
+	stmt, args, _ := pgSquirell.Insert("tasks").Columns(columns...).Values(values...).
+						Suffix("ON CONFLICT (series_id, occurrence_date) DO NOTHING RETURNING task_id").
+						ToSql()
+
+	var taskID int64
+	err := tx.QueryRowxContext(ctx, stmt, args...).Scan(&taskID)
+	if err == sql.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	stmt, args, _ = pgSquirell.Insert("task_status_history").Columns("task_id", "to_status").Values(taskID, "Pending").ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// endSeries stops the series before date and removes its open occurrences
+// from date on. Completed occurrences are kept as a record of the work done.
+func endSeries(ctx context.Context, tx *sqlx.Tx, series *Series, date time.Time) (err error) {
+	lastDate := date.AddDate(0, 0, -1)
+
+	setMap := map[string]interface{}{
+		"until_date":         lastDate,
+		"materialized_until": squirrel.Expr("LEAST(materialized_until, ?)", lastDate),
+		"updated_at":         squirrel.Expr("NOW()"),
+	}
+
+	// nothing is left of a series cut at or before its first day
+	if !date.After(series.StartDate) {
+		setMap["deleted_at"] = squirrel.Expr("NOW()")
+	}
+
+	stmt, args, _ := pgSquirell.Update("task_series").SetMap(setMap).Where(squirrel.Eq{"series_id": series.SeriesID}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	stmt, args, _ = pgSquirell.Update("tasks").SetMap(map[string]interface{}{
+		"deleted_at": squirrel.Expr("NOW()"),
+	}).Where(squirrel.And{
+		squirrel.Eq{"series_id": series.SeriesID, "deleted_at": nil},
+		squirrel.GtOrEq{"occurrence_date": date},
+		squirrel.NotEq{"status": "Completed"},
+	}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,306 @@
+package series
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+)
+
+// recurrence is the subset of an RFC 5545 RRULE that makes sense for
+// date-only tasks: FREQ=DAILY|WEEKLY|MONTHLY with INTERVAL, BYDAY (ordinals
+// only for MONTHLY), BYMONTHDAY, COUNT, UNTIL and WKST=MO.
+type recurrence struct {
+	freq       string
+	interval   int
+	byDay      []weekdayNum
+	byMonthDay []int
+	count      int
+	until      time.Time
+}
+
+type weekdayNum struct {
+	// n selects the nth weekday of the month, counted from the end when
+	// negative. Zero means every such weekday in the period.
+	n       int
+	weekday time.Weekday
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+func invalidRRule(format string, a ...any) error {
+	return exceptions.NewInvariantError("rrule is invalid: " + fmt.Sprintf(format, a...))
+}
+
+func parseRRule(rule string) (*recurrence, error) {
+	r := &recurrence{interval: 1}
+	rule = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(rule)), "RRULE:")
+
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, invalidRRule("%s has no value", part)
+		}
+
+		switch name {
+		case "FREQ":
+			if value != "DAILY" && value != "WEEKLY" && value != "MONTHLY" {
+				return nil, invalidRRule("FREQ=%s is not supported, use DAILY, WEEKLY or MONTHLY", value)
+			}
+			r.freq = value
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, invalidRRule("INTERVAL must be a positive number")
+			}
+			r.interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, invalidRRule("COUNT must be a positive number")
+			}
+			r.count = n
+		case "UNTIL":
+			until, err := parseRRuleDate(value)
+			if err != nil {
+				return nil, invalidRRule("UNTIL must be a date such as 20261231")
+			}
+			r.until = until
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				if len(day) < 2 {
+					return nil, invalidRRule("BYDAY=%s is not a weekday", day)
+				}
+
+				weekday, ok := rruleWeekdays[day[len(day)-2:]]
+				if !ok {
+					return nil, invalidRRule("BYDAY=%s is not a weekday", day)
+				}
+
+				n := 0
+				if prefix := day[:len(day)-2]; prefix != "" {
+					var err error
+					if n, err = strconv.Atoi(prefix); err != nil || n == 0 || n < -5 || n > 5 {
+						return nil, invalidRRule("BYDAY=%s has an invalid ordinal", day)
+					}
+				}
+
+				r.byDay = append(r.byDay, weekdayNum{n: n, weekday: weekday})
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(day)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, invalidRRule("BYMONTHDAY=%s is not a day of the month", day)
+				}
+				r.byMonthDay = append(r.byMonthDay, n)
+			}
+		case "WKST":
+			if value != "MO" {
+				return nil, invalidRRule("only WKST=MO is supported")
+			}
+		default:
+			return nil, invalidRRule("%s is not supported", name)
+		}
+	}
+
+	if r.freq == "" {
+		return nil, invalidRRule("FREQ is required")
+	}
+	if r.count > 0 && !r.until.IsZero() {
+		return nil, invalidRRule("COUNT and UNTIL cannot be combined")
+	}
+	if len(r.byMonthDay) > 0 && r.freq != "MONTHLY" {
+		return nil, invalidRRule("BYMONTHDAY is only supported with FREQ=MONTHLY")
+	}
+	for _, day := range r.byDay {
+		if day.n != 0 && r.freq != "MONTHLY" {
+			return nil, invalidRRule("BYDAY ordinals are only supported with FREQ=MONTHLY")
+		}
+	}
+
+	return r, nil
+}
+
+func parseRRuleDate(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102", "20060102T150405Z", "20060102T150405"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return toDate(t), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("cannot parse %s", value)
+}
+
+func toDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// occurrences returns the dates in [from, to] generated by the rule for a
+// series starting at dtstart. COUNT is always counted from dtstart, so the
+// same date keeps the same position however the window is chosen.
+func (r *recurrence) occurrences(dtstart time.Time, from time.Time, to time.Time) []time.Time {
+	dtstart, from, to = toDate(dtstart), toDate(from), toDate(to)
+	if !r.until.IsZero() && r.until.Before(to) {
+		to = r.until
+	}
+
+	result := []time.Time{}
+	emitted := 0
+
+	for period := 0; ; period++ {
+		periodStart, candidates := r.period(dtstart, period)
+		if periodStart.After(to) {
+			return result
+		}
+
+		for _, date := range candidates {
+			if date.Before(dtstart) {
+				continue
+			}
+			if date.After(to) {
+				return result
+			}
+
+			emitted++
+			if !date.Before(from) {
+				result = append(result, date)
+			}
+			if r.count > 0 && emitted >= r.count {
+				return result
+			}
+		}
+	}
+}
+
+func (r *recurrence) isOccurrence(dtstart time.Time, date time.Time) bool {
+	return len(r.occurrences(dtstart, date, date)) == 1
+}
+
+// period returns the start of the nth period after dtstart and the sorted
+// candidate dates inside it.
+func (r *recurrence) period(dtstart time.Time, n int) (time.Time, []time.Time) {
+	switch r.freq {
+	case "DAILY":
+		date := dtstart.AddDate(0, 0, n*r.interval)
+		if len(r.byDay) > 0 && !r.hasWeekday(date.Weekday()) {
+			return date, nil
+		}
+		return date, []time.Time{date}
+
+	case "WEEKLY":
+		weekStart := dtstart.AddDate(0, 0, -mondayOffset(dtstart.Weekday())+7*n*r.interval)
+
+		weekdays := []time.Weekday{dtstart.Weekday()}
+		if len(r.byDay) > 0 {
+			weekdays = weekdays[:0]
+			for _, day := range r.byDay {
+				weekdays = append(weekdays, day.weekday)
+			}
+		}
+
+		candidates := []time.Time{}
+		for _, weekday := range weekdays {
+			candidates = append(candidates, weekStart.AddDate(0, 0, mondayOffset(weekday)))
+		}
+		return weekStart, sortDates(candidates)
+
+	default:
+		monthStart := time.Date(dtstart.Year(), dtstart.Month()+time.Month(n*r.interval), 1, 0, 0, 0, 0, time.UTC)
+		lastDay := monthStart.AddDate(0, 1, -1).Day()
+
+		days := map[int]bool{}
+		if len(r.byMonthDay) > 0 {
+			for _, day := range r.byMonthDay {
+				if day < 0 {
+					day = lastDay + day + 1
+				}
+				if day >= 1 && day <= lastDay {
+					days[day] = true
+				}
+			}
+		}
+
+		if len(r.byDay) > 0 {
+			byDay := map[int]bool{}
+			for _, day := range r.byDay {
+				matches := []int{}
+				for d := 1; d <= lastDay; d++ {
+					if monthStart.AddDate(0, 0, d-1).Weekday() == day.weekday {
+						matches = append(matches, d)
+					}
+				}
+
+				switch {
+				case day.n == 0:
+					for _, d := range matches {
+						byDay[d] = true
+					}
+				case day.n > 0 && day.n <= len(matches):
+					byDay[matches[day.n-1]] = true
+				case day.n < 0 && -day.n <= len(matches):
+					byDay[matches[len(matches)+day.n]] = true
+				}
+			}
+
+			// BYDAY narrows BYMONTHDAY when both are given
+			if len(r.byMonthDay) > 0 {
+				for d := range days {
+					if !byDay[d] {
+						delete(days, d)
+					}
+				}
+			} else {
+				days = byDay
+			}
+		}
+
+		if len(r.byMonthDay) == 0 && len(r.byDay) == 0 && dtstart.Day() <= lastDay {
+			days[dtstart.Day()] = true
+		}
+
+		candidates := []time.Time{}
+		for d := range days {
+			candidates = append(candidates, monthStart.AddDate(0, 0, d-1))
+		}
+		return monthStart, sortDates(candidates)
+	}
+}
+
+func (r *recurrence) hasWeekday(weekday time.Weekday) bool {
+	for _, day := range r.byDay {
+		if day.weekday == weekday {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mondayOffset is the number of days from Monday, weeks start on Monday.
+func mondayOffset(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
+
+func sortDates(dates []time.Time) []time.Time {
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+
+	return dates
+}
@@ -0,0 +1,275 @@
+package series
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/agungramananda/sosmed-todolist/internal/common/httpres"
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+)
+
+// materializeHorizonDays is how far ahead of today the tasks of a series are
+// created. Occurrences further out only exist as the rule until the horizon
+// reaches them.
+const materializeHorizonDays = 56
+
+// maxOccurrenceYears bounds how far ahead an occurrence can be edited or
+// cancelled. Finding out whether a date is an occurrence walks the rule from
+// the start of the series, which must stay cheap.
+const maxOccurrenceYears = 2
+
+type SeriesService interface {
+	GetAll(context.Context, *SeriesRequestQuery) (*ListofSeries, error)
+	GetOne(context.Context, *SeriesRequestParams) (*SeriesDetails, error)
+	Create(context.Context, *SeriesRequestPayload) error
+	Update(context.Context, *SeriesRequestParams, *SeriesOccurrenceQuery, *SeriesRequestPayload) error
+	Delete(context.Context, *SeriesRequestParams, *SeriesOccurrenceQuery) error
+	MaterializeAll(context.Context) error
+}
+
+type seriesService struct {
+	repo SeriesRepository
+}
+
+func NewService(r SeriesRepository) *seriesService {
+	return &seriesService{repo: r}
+}
+
+func (svc *seriesService) GetAll(ctx context.Context, query *SeriesRequestQuery) (listOfSeries *ListofSeries, err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return &ListofSeries{}, err
+	}
+
+	limit := int(query.Limit)
+	page := int(query.Page)
+	utils.SetDefaultPagination(&limit, &page)
+
+	repoQuery := *query
+	repoQuery.Limit = uint64(limit)
+	repoQuery.Page = uint64(page)
+
+	series, err := svc.repo.GetAll(ctx, caller.WorkspaceID, &repoQuery)
+	if err != nil {
+		return &ListofSeries{}, err
+	}
+
+	listOfSeries = &ListofSeries{
+		Series: []*SeriesDetails{},
+		Meta: httpres.ListPagination{
+			Limit: repoQuery.Limit,
+			Page:  repoQuery.Page,
+		},
+	}
+
+	for _, s := range series {
+		listOfSeries.Series = append(listOfSeries.Series, toSeriesDetails(s))
+	}
+
+	totalItems, err := svc.repo.Count(ctx, caller.WorkspaceID, &repoQuery)
+	if err != nil {
+		return &ListofSeries{}, err
+	}
+
+	listOfSeries.Meta.TotalPage = utils.CountTotalPage(totalItems, repoQuery.Limit)
+	listOfSeries.Meta.HasMore = listOfSeries.Meta.Page < listOfSeries.Meta.TotalPage
+
+	return listOfSeries, nil
+}
+
+func (svc *seriesService) GetOne(ctx context.Context, params *SeriesRequestParams) (seriesDetails *SeriesDetails, err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return seriesDetails, err
+	}
+
+	series, err := svc.repo.GetByID(ctx, caller.WorkspaceID, params)
+	if err != nil {
+		return seriesDetails, err
+	}
+
+	return toSeriesDetails(series), nil
+}
+
+func (svc *seriesService) Create(ctx context.Context, payload *SeriesRequestPayload) (err error) {
+	caller, err := auth.Authorize(ctx, auth.PermCreateTasks)
+	if err != nil {
+		return err
+	}
+
+	if _, err = parseRRule(payload.RRule); err != nil {
+		return err
+	}
+
+	if payload.Hashtags, err = utils.NormalizeHashtags(payload.Hashtags); err != nil {
+		return err
+	}
+
+	seriesID, err := svc.repo.Add(ctx, caller.WorkspaceID, payload)
+	if err != nil {
+		return err
+	}
+
+	return svc.materializeByID(ctx, caller.WorkspaceID, seriesID)
+}
+
+// Update edits a single occurrence, or splits the series at the occurrence so
+// that it and every later one follow the payload while earlier occurrences
+// keep the old template.
+func (svc *seriesService) Update(ctx context.Context, params *SeriesRequestParams, query *SeriesOccurrenceQuery, payload *SeriesRequestPayload) (err error) {
+	series, occurrence, err := svc.getOccurrence(ctx, params, query)
+	if err != nil {
+		return err
+	}
+
+	if payload.Hashtags, err = utils.NormalizeHashtags(payload.Hashtags); err != nil {
+		return err
+	}
+
+	if query.Scope == ScopeThis {
+		return svc.repo.UpdateOccurrence(ctx, series, occurrence, payload)
+	}
+
+	if _, err = parseRRule(payload.RRule); err != nil {
+		return err
+	}
+
+	seriesID, err := svc.repo.Split(ctx, series, occurrence, payload)
+	if err != nil {
+		return err
+	}
+
+	return svc.materializeByID(ctx, series.WorkspaceID, seriesID)
+}
+
+func (svc *seriesService) Delete(ctx context.Context, params *SeriesRequestParams, query *SeriesOccurrenceQuery) (err error) {
+	series, occurrence, err := svc.getOccurrence(ctx, params, query)
+	if err != nil {
+		return err
+	}
+
+	if query.Scope == ScopeThis {
+		return svc.repo.CancelOccurrence(ctx, series, occurrence)
+	}
+
+	return svc.repo.CancelFuture(ctx, series, occurrence)
+}
+
+// MaterializeAll extends every series up to the horizon. It is run
+// periodically so the horizon keeps rolling forward.
+func (svc *seriesService) MaterializeAll(ctx context.Context) (err error) {
+	series, err := svc.repo.GetPending(ctx)
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	for _, s := range series {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err := svc.materialize(ctx, s); err != nil {
+			errs = append(errs, fmt.Errorf("series %d: %w", s.SeriesID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// getOccurrence finds the occurrence of a series the caller is about to change.
+// The occurrences are tasks nobody in particular created, so only a role that
+// may change every task may change them.
+func (svc *seriesService) getOccurrence(ctx context.Context, params *SeriesRequestParams, query *SeriesOccurrenceQuery) (*Series, time.Time, error) {
+	caller, err := auth.Authorize(ctx, auth.PermEditTasks)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	series, err := svc.repo.GetByID(ctx, caller.WorkspaceID, params)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	rule, err := parseRRule(series.RRule)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	occurrence, _ := time.Parse("2006-01-02", query.Occurrence)
+	if occurrence.After(toDate(time.Now()).AddDate(maxOccurrenceYears, 0, 0)) {
+		return nil, time.Time{}, exceptions.NewInvariantError(fmt.Sprintf("occurrence must be at most %d years ahead", maxOccurrenceYears))
+	}
+
+	ended := series.UntilDate.Valid && occurrence.After(toDate(series.UntilDate.Time))
+	if ended || !rule.isOccurrence(series.StartDate, occurrence) {
+		return nil, time.Time{}, exceptions.NewInvariantError(fmt.Sprintf("%s is not an occurrence of this series", query.Occurrence))
+	}
+
+	return series, occurrence, nil
+}
+
+func (svc *seriesService) materializeByID(ctx context.Context, workspaceID int64, seriesID int64) (err error) {
+	series, err := svc.repo.GetByID(ctx, workspaceID, &SeriesRequestParams{SeriesID: strconv.FormatInt(seriesID, 10)})
+	if err != nil {
+		return err
+	}
+
+	return svc.materialize(ctx, series)
+}
+
+func (svc *seriesService) materialize(ctx context.Context, series *Series) (err error) {
+	rule, err := parseRRule(series.RRule)
+	if err != nil {
+		return err
+	}
+
+	through := toDate(time.Now()).AddDate(0, 0, materializeHorizonDays)
+	if series.UntilDate.Valid && series.UntilDate.Time.Before(through) {
+		through = toDate(series.UntilDate.Time)
+	}
+
+	from := toDate(series.StartDate)
+	if series.MaterializedUntil.Valid {
+		from = toDate(series.MaterializedUntil.Time).AddDate(0, 0, 1)
+	}
+
+	if from.After(through) {
+		return nil
+	}
+
+	return svc.repo.Materialize(ctx, series, rule.occurrences(series.StartDate, from, through), through)
+}
+
+func toSeriesDetails(series *Series) *SeriesDetails {
+	details := &SeriesDetails{
+		SeriesID:   series.SeriesID,
+		Title:      series.Title,
+		BrandID:    series.BrandID,
+		Brand:      series.Brand,
+		PlatformID: series.PlatformID,
+		Platform:   series.Platform,
+		Payment:    series.Payment,
+		Caption:    series.Caption,
+		Hashtags:   series.Hashtags,
+		CTAURL:     series.CTAURL,
+		RRule:      series.RRule,
+		StartDate:  series.StartDate.Format("2006-01-02"),
+	}
+
+	if series.UntilDate.Valid {
+		untilDate := series.UntilDate.Time.Format("2006-01-02")
+		details.UntilDate = &untilDate
+	}
+	if series.MaterializedUntil.Valid {
+		materializedUntil := series.MaterializedUntil.Time.Format("2006-01-02")
+		details.MaterializedUntil = &materializedUntil
+	}
+
+	return details
+}
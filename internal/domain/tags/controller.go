@@ -0,0 +1,32 @@
+package tags
+
+import (
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type TagsController struct {
+	svc TagsService
+}
+
+func NewController(svc TagsService) *TagsController {
+	return &TagsController{
+		svc: svc,
+	}
+}
+
+const (
+	tagsBasepath = "/tags"
+)
+
+func (con *TagsController) Route(grp *echo.Group){
+	subrouter := grp.Group(tagsBasepath, middleware.RequireScopes(auth.ScopeTagsRead, auth.ScopeTagsWrite))
+
+	subrouter.GET("", HandleGetAllTags(con.svc.GetAll))
+	subrouter.GET("/:tag_id", HandleGetOneTags(con.svc.GetOne))
+	subrouter.POST("", HandleCreateTags(con.svc.Create))
+	subrouter.PUT("/:tag_id", HandleUpdateTags(con.svc.Update))
+	subrouter.PATCH("/:tag_id", HandlePatchTags(con.svc.Patch))
+	subrouter.DELETE("/:tag_id", HandleDeleteTags(con.svc.Delete))
+}
@@ -0,0 +1,36 @@
+package tags
+
+import "github.com/agungramananda/sosmed-todolist/internal/common/httpres"
+
+type TagRequestParams struct {
+	TagID string `param:"tag_id" validate:"required"`
+}
+
+type TagRequestPayload struct {
+	TagID int64  `json:"-"`
+	Tag   string `json:"tag" validate:"required,max=100,min=1" example:"needs-legal"`
+}
+
+// TagPatchPayload is a JSON Merge Patch of a tag, only the fields present in
+// the request are validated and updated.
+type TagPatchPayload struct {
+	Tag *string `json:"tag" validate:"omitnil,max=100,min=1"`
+}
+
+type TagRequestQuery struct {
+	Keyword string `query:"keyword" validate:"omitempty,max=100"`
+	Sort    string `query:"sort" validate:"omitempty,max=100"`
+	Cursor  string `query:"cursor" validate:"omitempty,max=1000"`
+	Limit   uint64 `query:"limit" validate:"omitempty,min=1,max=100"`
+	Page    uint64 `query:"page" validate:"omitempty,min=1"`
+}
+
+type TagDetails struct {
+	TagID int64  `json:"tag_id"`
+	Tag   string `json:"tag" example:"needs-legal"`
+}
+
+type ListofTags struct {
+	Tags []*TagDetails          `json:"tags"`
+	Meta httpres.ListPagination `json:"meta"`
+}
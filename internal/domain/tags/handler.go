@@ -0,0 +1,242 @@
+package tags
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type GetAllTagsHandler func(context.Context, *TagRequestQuery) (*ListofTags, error)
+type GetOneTagsHandler func(context.Context, *TagRequestParams) (*TagDetails, error)
+type CreateTagsHandler func(context.Context, *TagRequestPayload) error
+type UpdateTagsHandler func(context.Context, *TagRequestParams, *TagRequestPayload) error
+type PatchTagsHandler func(context.Context, *TagRequestParams, *TagPatchPayload) error
+type DeleteTagsHandler func(context.Context, *TagRequestParams) error
+
+// Get All Tags godoc
+//
+//	@Summary	Get all tags
+//	@Tags		Tag
+//	@Produce	json
+//	@Param		keyword	query		string	false	"Keyword to search"
+//	@Param		sort	query		string	false	"Comma separated sort keys, prefix with - for descending (tag_id, tag, created_at)"
+//	@Param		cursor	query		string	false	"Cursor from a previous next_cursor or prev_cursor, page is ignored when set"
+//	@Param		limit	query		int		false	"Number of entities per page"
+//	@Param		page	query		int		false	"Page number"
+//	@Success	200		{object}	ListofTags	"Successfully fetched all tags"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tags [get]
+func HandleGetAllTags(handler GetAllTagsHandler) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		ctx := c.Request().Context()
+		query := &TagRequestQuery{}
+
+		if err = c.Bind(query); err != nil {
+			return err
+		}
+
+		if err = c.Validate(query); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "All tags fetched successfully")
+	}
+}
+
+// Get One Tag godoc
+//
+//	@Summary	Get a single tag by ID
+//	@Tags		Tag
+//	@Produce	json
+//	@Param		id	path	string	true	"Tag ID"
+//	@Success	200		{object}	TagDetails	"Successfully fetched the tag"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Tag not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tags/{id} [get]
+func HandleGetOneTags(handler GetOneTagsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &TagRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "Tag fetched successfully")
+	}
+}
+
+// Create Tag godoc
+//
+//	@Summary	Create a new tag
+//	@Tags		Tag
+//	@Accept		json
+//	@Produce	json
+//	@Param		body	body	TagRequestPayload	true	"Tag details"
+//	@Success	201		{object}	httpres.BaseResponse	"Tag successfully created"
+//	@Failure	400		{object}	httpres.ErrorResponse			"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse			"Role may not manage tags"
+//	@Failure	500		{object}	httpres.ErrorResponse			"Internal server error"
+//	@Router		/tags [post]
+func HandleCreateTags(handler CreateTagsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		payload := &TagRequestPayload{}
+
+		if err := c.Bind(payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		err := handler(ctx, payload)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusCreated, nil, "New tag successfully added")
+	}
+}
+
+// Update Tag godoc
+//
+//	@Summary	Update an existing tag
+//	@Tags		Tag
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path	string					true	"Tag ID"
+//	@Param		body	body	TagRequestPayload	true	"Updated tag details"
+//	@Success	200		{object}	httpres.BaseResponse	"Tag updated successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not manage tags"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Tag not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tags/{id} [put]
+func HandleUpdateTags(handler UpdateTagsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &TagRequestParams{}
+		payload := &TagRequestPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Tag updated successfully")
+	}
+}
+
+// Patch Tag godoc
+//
+//	@Summary	Partially update an existing tag
+//	@Tags		Tag
+//	@Accept		json
+//	@Accept		application/merge-patch+json
+//	@Produce	json
+//	@Param		id		path	string				true	"Tag ID"
+//	@Param		body	body	TagPatchPayload	true	"Tag fields to change"
+//	@Success	200		{object}	httpres.BaseResponse	"Tag updated successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not manage tags"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Tag not found"
+//	@Failure	415		{object}	httpres.ErrorResponse	"Unsupported media type"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tags/{id} [patch]
+func HandlePatchTags(handler PatchTagsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &TagRequestParams{}
+		payload := &TagPatchPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := utils.BindMergePatch(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Tag updated successfully")
+	}
+}
+
+// Delete Tag godoc
+//
+//	@Summary	Delete a tag by ID
+//	@Tags		Tag
+//	@Produce	json
+//	@Param		id	path	string	true	"Tag ID"
+//	@Success	200		{object}	httpres.BaseResponse	"Tag deleted successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not manage tags"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Tag not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tags/{id} [delete]
+func HandleDeleteTags(handler DeleteTagsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &TagRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Tag deleted successfully")
+	}
+}
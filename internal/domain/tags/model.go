@@ -0,0 +1,9 @@
+package tags
+
+import "time"
+
+type Tags struct {
+	TagID     int64     `db:"tag_id"`
+	Tag       string    `db:"tag"`
+	CreatedAt time.Time `db:"created_at"`
+}
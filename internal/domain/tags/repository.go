@@ -0,0 +1,239 @@
+package tags
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/jmoiron/sqlx"
+)
+
+var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+var tagsSortColumns = map[string]string{
+	"tag_id":     "g.tag_id",
+	"tag":        "g.tag",
+	"created_at": "g.created_at",
+}
+
+// tagsSortValue returns the value of a sortable column for a row, as stored
+// in a pagination cursor.
+func tagsSortValue(tag *Tags, key string) string {
+	switch key {
+	case "tag_id":
+		return strconv.FormatInt(tag.TagID, 10)
+	case "tag":
+		return tag.Tag
+	case "created_at":
+		return tag.CreatedAt.Format(utils.CursorTimeFormat)
+	}
+
+	return ""
+}
+
+type TagsRepository interface {
+	GetAll(context.Context, int64, *TagRequestQuery) ([]*Tags, error)
+	Count(context.Context, int64, *TagRequestQuery) (uint64, error)
+	GetByID(context.Context, int64, *TagRequestParams) (*Tags, error)
+	Add(context.Context, int64, *TagRequestPayload) (int64, error)
+	Update(context.Context, int64, *TagRequestPayload, *TagRequestParams) error
+	Patch(context.Context, int64, *TagPatchPayload, *TagRequestParams) error
+	Delete(context.Context, int64, *TagRequestParams) error
+}
+
+type tagsRepository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) TagsRepository {
+	return &tagsRepository{
+		db: db,
+	}
+}
+
+func (r *tagsRepository) GetAll(ctx context.Context, workspaceID int64, query *TagRequestQuery) (resp []*Tags, err error) {
+	keyword := query.Keyword
+	utils.KeywordHelper(&keyword)
+
+	resp = []*Tags{}
+
+	sortFields, err := utils.ParseSort(query.Sort, tagsSortColumns, "tag_id")
+	if err != nil {
+		return resp, err
+	}
+
+	builder := pgSquirell.Select("g.tag_id", "g.tag", "g.created_at").From("tags g").Where(squirrel.And{squirrel.Eq{"g.deleted_at": nil, "g.workspace_id": workspaceID}, squirrel.ILike{"g.tag": keyword}})
+
+	// one extra row is fetched so the service can tell whether more rows follow
+	if query.Cursor != "" {
+		cursor, err := utils.DecodeCursor(query.Cursor, sortFields)
+		if err != nil {
+			return resp, err
+		}
+		if cursor.Backward {
+			sortFields = utils.ReverseSort(sortFields)
+		}
+
+		builder = builder.Where(utils.KeysetPredicate(sortFields, cursor.Values)).OrderBy(utils.OrderByClauses(sortFields)...).Limit(query.Limit + 1)
+	} else {
+		builder = builder.OrderBy(utils.OrderByClauses(sortFields)...).Limit(query.Limit + 1).Offset((query.Page - 1) * query.Limit)
+	}
+
+	stmt, args, _ := builder.ToSql()
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (r *tagsRepository) GetByID(ctx context.Context, workspaceID int64, params *TagRequestParams) (resp *Tags, err error) {
+	stmt, args, _ := pgSquirell.Select("g.tag_id", "g.tag", "g.created_at").From("tags g").Where(squirrel.And{squirrel.Eq{"g.deleted_at": nil, "g.workspace_id": workspaceID}, squirrel.Eq{"g.tag_id": params.TagID}}).ToSql()
+
+	resp = &Tags{}
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).StructScan(resp)
+	if err != nil && err != sql.ErrNoRows {
+		return resp, err
+	} else if err == sql.ErrNoRows {
+		return resp, exceptions.NewNotFoundError("tags not found")
+	}
+
+	return resp, nil
+}
+
+func (r *tagsRepository) Count(ctx context.Context, workspaceID int64, query *TagRequestQuery) (resp uint64, err error) {
+	keyword := query.Keyword
+	utils.KeywordHelper(&keyword)
+
+	stmt, args, _ := pgSquirell.Select("count(tag_id)").From("tags").Where(squirrel.And{squirrel.Eq{"deleted_at": nil, "workspace_id": workspaceID}, squirrel.ILike{"tag": keyword}}).ToSql()
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&resp)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (r *tagsRepository) Add(ctx context.Context, workspaceID int64, payload *TagRequestPayload) (tagID int64, err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer tx.Rollback()
+
+	if err = checkUnique(ctx, tx, workspaceID, payload.Tag, nil); err != nil {
+		return 0, err
+	}
+
+	stmt, args, _ := pgSquirell.Insert("tags").Columns("workspace_id", "tag").Values(workspaceID, payload.Tag).Suffix("RETURNING tag_id").ToSql()
+
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&tagID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return tagID, nil
+}
+
+func (r *tagsRepository) Update(ctx context.Context, workspaceID int64, payload *TagRequestPayload, params *TagRequestParams) (err error) {
+	return r.Patch(ctx, workspaceID, &TagPatchPayload{Tag: &payload.Tag}, params)
+}
+
+func (r *tagsRepository) Patch(ctx context.Context, workspaceID int64, payload *TagPatchPayload, params *TagRequestParams) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var stmt string
+	var args []any
+	var count int64
+
+	stmt, args, _ = pgSquirell.Select("count(*)").From("tags").Where(squirrel.And{squirrel.Eq{"deleted_at": nil, "workspace_id": workspaceID}, squirrel.Eq{"tag_id": params.TagID}}).ToSql()
+
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return err
+	} else if count == 0 {
+		return exceptions.NewNotFoundError("tags not found")
+	}
+
+	setMap := map[string]interface{}{
+		"updated_at": squirrel.Expr("NOW()"),
+	}
+
+	if payload.Tag != nil {
+		if err = checkUnique(ctx, tx, workspaceID, *payload.Tag, params.TagID); err != nil {
+			return err
+		}
+		setMap["tag"] = *payload.Tag
+	}
+
+	stmt, args, _ = pgSquirell.Update("tags").SetMap(setMap).Where(squirrel.Eq{"tag_id": params.TagID, "workspace_id": workspaceID}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Delete removes a tag, the tasks it was on simply stop showing it.
+func (r *tagsRepository) Delete(ctx context.Context, workspaceID int64, params *TagRequestParams) error {
+	stmt, args, _ := pgSquirell.Update("tags").SetMap(map[string]interface{}{
+		"deleted_at": squirrel.Expr("NOW()"),
+	}).Where(squirrel.Eq{"tag_id": params.TagID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
+
+	result, err := r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("tags not found")
+	}
+
+	return nil
+}
+
+// checkUnique fails when another tag of the workspace already has the name,
+// ignoring case.
+func checkUnique(ctx context.Context, tx *sqlx.Tx, workspaceID int64, tag string, exceptID any) error {
+	var count int64
+
+	filter := squirrel.And{squirrel.Eq{"deleted_at": nil, "workspace_id": workspaceID}, squirrel.Expr("lower(tag) = lower(?)", tag)}
+	if exceptID != nil {
+		filter = append(filter, squirrel.NotEq{"tag_id": exceptID})
+	}
+
+	stmt, args, _ := pgSquirell.Select("count(*)").From("tags").Where(filter).ToSql()
+
+	err := tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return err
+	} else if count > 0 {
+		return exceptions.NewInvariantError("tag already exists")
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package tags
+
+import (
+	"context"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/httpres"
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+)
+
+type TagsService interface {
+	GetAll(context.Context, *TagRequestQuery) (*ListofTags, error)
+	GetOne(context.Context, *TagRequestParams) (*TagDetails, error)
+	Create(context.Context, *TagRequestPayload) error
+	Update(context.Context, *TagRequestParams, *TagRequestPayload) error
+	Patch(context.Context, *TagRequestParams, *TagPatchPayload) error
+	Delete(context.Context, *TagRequestParams) error
+}
+
+type tagsService struct {
+	repo TagsRepository
+}
+
+func NewService(r TagsRepository) *tagsService {
+	return &tagsService{repo: r}
+}
+
+func (svc *tagsService) GetAll(ctx context.Context, query *TagRequestQuery) (listOfTags *ListofTags, err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return &ListofTags{}, err
+	}
+
+	limit := int(query.Limit)
+	page := int(query.Page)
+	utils.SetDefaultPagination(&limit, &page)
+
+	repoQuery := &TagRequestQuery{
+		Keyword: query.Keyword,
+		Sort:    query.Sort,
+		Cursor:  query.Cursor,
+		Limit:   uint64(limit),
+		Page:    uint64(page),
+	}
+
+	listOfTags = &ListofTags{
+		Tags: []*TagDetails{},
+		Meta: httpres.ListPagination{
+			Limit:     repoQuery.Limit,
+			Page:      repoQuery.Page,
+			TotalPage: 0,
+		},
+	}
+
+	sortFields, err := utils.ParseSort(repoQuery.Sort, tagsSortColumns, "tag_id")
+	if err != nil {
+		return &ListofTags{}, err
+	}
+
+	var cursor *utils.Cursor
+	if repoQuery.Cursor != "" {
+		if cursor, err = utils.DecodeCursor(repoQuery.Cursor, sortFields); err != nil {
+			return &ListofTags{}, err
+		}
+		listOfTags.Meta.Page = 0
+	}
+
+	tags, err := svc.repo.GetAll(ctx, caller.WorkspaceID, repoQuery)
+	if err != nil {
+		return &ListofTags{}, err
+	}
+
+	tags = utils.PaginateKeyset(tags, &listOfTags.Meta, sortFields, cursor, tagsSortValue)
+
+	for _, tag := range tags {
+		listOfTags.Tags = append(listOfTags.Tags, &TagDetails{
+			TagID: tag.TagID,
+			Tag:   tag.Tag,
+		})
+	}
+
+	// keyset pages skip the COUNT query, which is what makes them cheap
+	if cursor != nil {
+		return listOfTags, nil
+	}
+
+	totalItems, err := svc.repo.Count(ctx, caller.WorkspaceID, repoQuery)
+	if err != nil {
+		return &ListofTags{}, err
+	}
+
+	listOfTags.Meta.TotalPage = utils.CountTotalPage(totalItems, repoQuery.Limit)
+
+	return listOfTags, nil
+}
+
+func (svc *tagsService) GetOne(ctx context.Context, params *TagRequestParams) (tagDetails *TagDetails, err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return tagDetails, err
+	}
+
+	tag, err := svc.repo.GetByID(ctx, caller.WorkspaceID, params)
+	if err != nil {
+		return tagDetails, err
+	}
+
+	tagDetails = &TagDetails{
+		TagID: tag.TagID,
+		Tag:   tag.Tag,
+	}
+
+	return tagDetails, nil
+}
+
+func (svc *tagsService) Create(ctx context.Context, payload *TagRequestPayload) (err error) {
+	caller, err := auth.Authorize(ctx, auth.PermManageCatalog)
+	if err != nil {
+		return err
+	}
+
+	_, err = svc.repo.Add(ctx, caller.WorkspaceID, payload)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *tagsService) Update(ctx context.Context, params *TagRequestParams, payload *TagRequestPayload) (err error) {
+	caller, err := auth.Authorize(ctx, auth.PermManageCatalog)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Update(ctx, caller.WorkspaceID, payload, params)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *tagsService) Patch(ctx context.Context, params *TagRequestParams, payload *TagPatchPayload) (err error) {
+	caller, err := auth.Authorize(ctx, auth.PermManageCatalog)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Patch(ctx, caller.WorkspaceID, payload, params)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *tagsService) Delete(ctx context.Context, params *TagRequestParams) (err error) {
+	caller, err := auth.Authorize(ctx, auth.PermManageCatalog)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Delete(ctx, caller.WorkspaceID, params)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
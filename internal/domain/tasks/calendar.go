@@ -0,0 +1,39 @@
+package tasks
+
+import "time"
+
+const (
+	GranularityDay   = "day"
+	GranularityWeek  = "week"
+	GranularityMonth = "month"
+)
+
+// maxCalendarDays bounds the range of a calendar request, since every task in
+// the range is returned.
+const maxCalendarDays = 366
+
+// bucketStart truncates a date the same way date_trunc does in
+// GetCalendarSummary, weeks start on Monday.
+func bucketStart(date time.Time, granularity string) time.Time {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch granularity {
+	case GranularityWeek:
+		return date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+	case GranularityMonth:
+		return date.AddDate(0, 0, 1-date.Day())
+	}
+
+	return date
+}
+
+func nextBucketStart(start time.Time, granularity string) time.Time {
+	switch granularity {
+	case GranularityWeek:
+		return start.AddDate(0, 0, 7)
+	case GranularityMonth:
+		return start.AddDate(0, 1, 0)
+	}
+
+	return start.AddDate(0, 0, 1)
+}
@@ -1,6 +1,13 @@
 package tasks
 
-import "github.com/labstack/echo/v4"
+import (
+	"fmt"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/labstack/echo/v4"
+	ecmiddleware "github.com/labstack/echo/v4/middleware"
+)
 
 type TasksController struct {
 	svc TasksService
@@ -14,14 +21,30 @@ func NewController(svc TasksService) *TasksController {
 
 const (
 	tasksBasepath = "/tasks"
+	meBasepath    = "/me"
 )
 
 func (con *TasksController) Route(grp *echo.Group){
-	subrouter := grp.Group(tasksBasepath)
+	subrouter := grp.Group(tasksBasepath, middleware.RequireScopes(auth.ScopeTasksRead, auth.ScopeTasksWrite))
+
+	// leave room for the multipart envelope around the file itself
+	importBodyLimit := ecmiddleware.BodyLimit(fmt.Sprintf("%dM", maxImportSizeMB+1))
 
 	subrouter.GET("", HandleGetAllTasks(con.svc.GetAll))
+	subrouter.GET("/calendar", HandleGetTaskCalendar(con.svc.GetCalendar))
+	subrouter.GET("/feed.ics", HandleGetTaskFeed(con.svc.WriteFeed))
+	subrouter.GET("/export.csv", HandleExportTasks(con.svc.WriteExport))
 	subrouter.GET("/:task_id", HandleGetOneTasks(con.svc.GetOne))
 	subrouter.POST("",HandleCreateTasks(con.svc.Create))
+	subrouter.POST("/bulk", HandleBulkTasks(con.svc.Bulk))
+	subrouter.POST("/import", HandleImportTasks(con.svc.Import), importBodyLimit)
 	subrouter.PUT("/:task_id", HandleUpdateTasks(con.svc.Update))
+	subrouter.PATCH("/:task_id", HandlePatchTasks(con.svc.Patch))
 	subrouter.DELETE("/:task_id", HandleDeleteTasks(con.svc.Delete))
+	subrouter.POST("/:task_id/reopen", HandleReopenTasks(con.svc.Reopen))
+	subrouter.PUT("/:task_id/platforms/:platform_id", HandleUpdateTaskPlatforms(con.svc.UpdatePlatform))
+	subrouter.GET("/:task_id/history", HandleGetTaskStatusHistory(con.svc.GetStatusHistory))
+
+	me := grp.Group(meBasepath, middleware.RequireScopes(auth.ScopeTasksRead, auth.ScopeTasksWrite))
+	me.GET("/tasks", HandleGetMyTasks(con.svc.GetMine))
 }
\ No newline at end of file
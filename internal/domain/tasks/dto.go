@@ -1,6 +1,11 @@
 package tasks
 
-import "github.com/agungramananda/sosmed-todolist/internal/common/httpres"
+import (
+	"database/sql"
+	"mime/multipart"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/httpres"
+)
 
 type TaskRequestParams struct {
 	TaskID string `param:"task_id" validate:"required"`
@@ -8,18 +13,94 @@ type TaskRequestParams struct {
 
 type TaskRequestPayload struct {
 	TaskID     int64  `json:"-"`
+	// CreatedBy is set by the service to the caller creating the task.
+	CreatedBy  sql.NullInt64 `json:"-"`
 	Title      string `json:"title" validate:"required"`
 	BrandID    int64  `json:"brand_id" validate:"omitempty,min=1"`
 	PlatformID int64  `json:"platform_id" validate:"omitempty,min=1"`
+	// PlatformIDs cross-posts the task, platform_id is then its primary
+	// platform and defaults to the first one.
+	PlatformIDs []int64 `json:"platform_ids" validate:"omitempty,max=10,dive,min=1"`
 	DueDate    string `json:"due_date" validate:"required,datetime=2006-01-02"`
-	Payment    int64  `json:"payment" validate:"required"`
+	Payment    int64  `json:"payment" validate:"required,min=1"`
 	Status     string `json:"status" validate:"required,oneof='Pending' 'Completed' 'Scheduled'"`
+	Caption    string   `json:"caption" validate:"omitempty,max=5000"`
+	Hashtags   []string `json:"hashtags" validate:"omitempty,max=30,dive,max=100"`
+	CTAURL     string   `json:"cta_url" validate:"omitempty,url,max=2048"`
+	// Tags replaces the tags of the task by tag ID, left out they are kept.
+	Tags       []int64  `json:"tags" validate:"omitempty,max=20,dive,min=1"`
+	// AssigneeID and ReviewerID must be members of the workspace. Left out of
+	// an update they are kept, 0 takes them off the task.
+	AssigneeID *int64   `json:"assignee_id" validate:"omitnil,min=0" example:"3"`
+	ReviewerID *int64   `json:"reviewer_id" validate:"omitnil,min=0" example:"2"`
+}
+
+// TaskPatchPayload is a JSON Merge Patch of a task, only the fields present in
+// the request are validated and updated.
+type TaskPatchPayload struct {
+	Title      *string `json:"title" validate:"omitnil,min=1"`
+	BrandID    *int64  `json:"brand_id" validate:"omitnil,min=1"`
+	PlatformID *int64  `json:"platform_id" validate:"omitnil,min=1"`
+	PlatformIDs *[]int64 `json:"platform_ids" validate:"omitnil,min=1,max=10,dive,min=1"`
+	DueDate    *string `json:"due_date" validate:"omitnil,datetime=2006-01-02"`
+	Payment    *int64  `json:"payment" validate:"omitnil,min=1"`
+	Status     *string `json:"status" validate:"omitnil,oneof='Pending' 'Completed' 'Scheduled'"`
+	Caption    *string   `json:"caption" validate:"omitempty,max=5000"`
+	Hashtags   *[]string `json:"hashtags" validate:"omitempty,max=30,dive,max=100"`
+	CTAURL     *string   `json:"cta_url" validate:"omitempty,url,max=2048"`
+	Tags       *[]int64  `json:"tags" validate:"omitnil,max=20,dive,min=1"`
+	// AssigneeID and ReviewerID of 0 take the assignee or reviewer off the
+	// task, null cannot be sent in a merge patch.
+	AssigneeID *int64    `json:"assignee_id" validate:"omitnil,min=0" example:"3"`
+	ReviewerID *int64    `json:"reviewer_id" validate:"omitnil,min=0" example:"2"`
+}
+
+const (
+	AssigneeMe         = "me"
+	AssigneeUnassigned = "unassigned"
+)
+
+// TaskFilter holds the filters shared by the list and export endpoints.
+type TaskFilter struct {
+	Keyword    string   `query:"keyword" validate:"omitempty,max=100"`
+	Status     []string `query:"status" validate:"omitempty,dive,oneof='Pending' 'Completed' 'Scheduled'"`
+	BrandID    []int64  `query:"brand_id" validate:"omitempty,dive,min=1"`
+	PlatformID []int64  `query:"platform_id" validate:"omitempty,dive,min=1"`
+	DueFrom    string   `query:"due_from" validate:"omitempty,datetime=2006-01-02"`
+	DueTo      string   `query:"due_to" validate:"omitempty,datetime=2006-01-02"`
+	MinPayment *int64   `query:"min_payment" validate:"omitempty,min=0"`
+	MaxPayment *int64   `query:"max_payment" validate:"omitempty,min=0"`
+	Hashtag    []string `query:"hashtag" validate:"omitempty,dive,max=100"`
+	Overdue    *bool    `query:"overdue"`
+	IncompleteChecklist *bool `query:"incomplete_checklist"`
+	// Tag matches tags by name, a task needs any of them or, with
+	// tag_match=all, every one of them.
+	Tag        []string `query:"tag" validate:"omitempty,max=20,dive,min=1,max=100"`
+	TagMatch   string   `query:"tag_match" validate:"omitempty,oneof=any all"`
+	// Assignee is me, unassigned or the ID of a user.
+	Assignee   string   `query:"assignee" validate:"omitempty,max=20"`
+	// assigneeID is Assignee resolved by the service.
+	assigneeID int64
 }
 
 type TaskRequestQuery struct {
-	Keyword string `query:"keyword" validate:"omitempty,max=100"`
-	Limit   uint64 `query:"limit" validate:"omitempty,min=1,max=100"`
-	Page    uint64 `query:"page" validate:"omitempty,min=1"`
+	TaskFilter
+	Sort   string `query:"sort" validate:"omitempty,max=100"`
+	Cursor string `query:"cursor" validate:"omitempty,max=1000"`
+	Limit  uint64 `query:"limit" validate:"omitempty,min=1,max=100"`
+	Page   uint64 `query:"page" validate:"omitempty,min=1"`
+}
+
+// TaskMineQuery pages through the open tasks assigned to the caller.
+type TaskMineQuery struct {
+	Limit uint64 `query:"limit" validate:"omitempty,min=1,max=100"`
+	Page  uint64 `query:"page" validate:"omitempty,min=1"`
+}
+
+// TaskExportQuery selects the tasks of an export, it has no limit.
+type TaskExportQuery struct {
+	TaskFilter
+	Sort string `query:"sort" validate:"omitempty,max=100"`
 }
 
 type TaskDetails struct {
@@ -32,9 +113,152 @@ type TaskDetails struct {
 	DueDate    string `json:"due_date"`
 	Payment    string  `json:"payment"`
 	Status     string `json:"status"`
+	Caption    string   `json:"caption"`
+	Hashtags   []string `json:"hashtags"`
+	CTAURL     string   `json:"cta_url"`
+	Overdue    bool     `json:"overdue"`
+	// CreatedBy is the user who created the task, null for tasks made before
+	// there were users or by a series.
+	CreatedBy  *int64   `json:"created_by" example:"3"`
+	AssigneeID *int64   `json:"assignee_id" example:"3"`
+	ReviewerID *int64   `json:"reviewer_id" example:"2"`
+	Platforms  []*TaskPlatformDetails `json:"platforms"`
+	// Progress is the percentage of checklist items done, null without a
+	// checklist.
+	Progress   *int     `json:"progress" example:"60"`
+	// CommentCount counts the comments and replies that are not deleted.
+	CommentCount int    `json:"comment_count" example:"4"`
+	Tags       []*TaskTagDetails `json:"tags"`
+}
+
+type TaskTagDetails struct {
+	TagID int64  `json:"tag_id"`
+	Tag   string `json:"tag" example:"needs-legal"`
+}
+
+type TaskPlatformParams struct {
+	TaskID     string `param:"task_id" validate:"required"`
+	PlatformID string `param:"platform_id" validate:"required"`
+}
+
+// TaskPlatformPayload sets how a task is doing on one of its platforms, a
+// null payment means the platform has no payment of its own.
+type TaskPlatformPayload struct {
+	Status  string `json:"status" validate:"required,oneof='Pending' 'Completed' 'Scheduled'"`
+	Payment *int64 `json:"payment" validate:"omitnil,min=0"`
+}
+
+type TaskPlatformDetails struct {
+	PlatformID int64   `json:"platform_id"`
+	Platform   string  `json:"platform"`
+	Primary    bool    `json:"primary"`
+	Status     string  `json:"status"`
+	Payment    *string `json:"payment" example:"150000.00"`
 }
 
 type ListofTasks struct {
 	Tasks []*TaskDetails `json:"tasks"`
 	Meta   httpres.ListPagination `json:"meta"`
-}
\ No newline at end of file
+}
+
+// TaskStatusHistoryDetails with a platform is a change of the status of the
+// task on that platform, the other changes are of the task itself.
+type TaskStatusHistoryDetails struct {
+	HistoryID  int64   `json:"history_id"`
+	PlatformID *int64  `json:"platform_id" example:"2"`
+	FromStatus *string `json:"from_status" example:"Scheduled"`
+	ToStatus   string  `json:"to_status" example:"Completed"`
+	ChangedAt  string  `json:"changed_at" example:"2026-11-02T09:30:00Z"`
+}
+
+type ListofTaskStatusHistory struct {
+	History []*TaskStatusHistoryDetails `json:"history"`
+}
+
+type TaskCalendarQuery struct {
+	From        string   `query:"from" validate:"required,datetime=2006-01-02"`
+	To          string   `query:"to" validate:"required,datetime=2006-01-02"`
+	Granularity string   `query:"granularity" validate:"omitempty,oneof=day week month"`
+	Status      []string `query:"status" validate:"omitempty,dive,oneof='Pending' 'Completed' 'Scheduled'"`
+	BrandID     []int64  `query:"brand_id" validate:"omitempty,dive,min=1"`
+	PlatformID  []int64  `query:"platform_id" validate:"omitempty,dive,min=1"`
+}
+
+type TaskStatusCounts struct {
+	Pending   int64 `json:"Pending"`
+	Completed int64 `json:"Completed"`
+	Scheduled int64 `json:"Scheduled"`
+}
+
+type TaskCalendarBucket struct {
+	Start        string           `json:"start" example:"2026-11-02"`
+	End          string           `json:"end" example:"2026-11-08"`
+	Total        int64            `json:"total"`
+	StatusCounts TaskStatusCounts `json:"status_counts"`
+	TotalPayment string           `json:"total_payment" example:"1500000.00"`
+	Tasks        []*TaskDetails   `json:"tasks"`
+}
+
+type TaskCalendar struct {
+	From        string                `json:"from" example:"2026-11-01"`
+	To          string                `json:"to" example:"2026-11-30"`
+	Granularity string                `json:"granularity" example:"week"`
+	Buckets     []*TaskCalendarBucket `json:"buckets"`
+}
+
+type TaskFeedQuery struct {
+	BrandID    []int64 `query:"brand_id" validate:"omitempty,dive,min=1"`
+	PlatformID []int64 `query:"platform_id" validate:"omitempty,dive,min=1"`
+}
+
+type TaskImportQuery struct {
+	DryRun bool `query:"dry_run"`
+}
+
+type TaskImportPayload struct {
+	File *multipart.FileHeader `form:"file" validate:"required"`
+}
+
+type TaskImportRowError struct {
+	Row    int      `json:"row" example:"3"`
+	Errors []string `json:"errors" example:"brand Acme does not exist"`
+}
+
+type TaskImportResult struct {
+	DryRun      bool                  `json:"dry_run"`
+	TotalRows   int                   `json:"total_rows" example:"25"`
+	ValidRows   int                   `json:"valid_rows" example:"24"`
+	InvalidRows int                   `json:"invalid_rows" example:"1"`
+	TaskIDs     []int64               `json:"task_ids"`
+	Errors      []*TaskImportRowError `json:"errors"`
+}
+
+const (
+	BulkSetStatus    = "set_status"
+	BulkShiftDueDate = "shift_due_date"
+	BulkSetPlatform  = "set_platform"
+	BulkDelete       = "delete"
+)
+
+// TaskBulkPayload applies one operation to many tasks. Only the field of the
+// chosen operation is used.
+type TaskBulkPayload struct {
+	TaskIDs    []int64 `json:"task_ids" validate:"required,min=1,max=500,dive,min=1"`
+	Operation  string  `json:"operation" validate:"required,oneof=set_status shift_due_date set_platform delete" enums:"set_status,shift_due_date,set_platform,delete"`
+	Status     string  `json:"status" validate:"required_if=Operation set_status,omitempty,oneof='Pending' 'Completed' 'Scheduled'"`
+	Days       int     `json:"days" validate:"required_if=Operation shift_due_date,min=-3650,max=3650" example:"7"`
+	PlatformID int64   `json:"platform_id" validate:"required_if=Operation set_platform,omitempty,min=1"`
+}
+
+type TaskBulkItemResult struct {
+	TaskID  int64  `json:"task_id"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty" example:"cannot change status from Completed to Scheduled"`
+}
+
+type TaskBulkResult struct {
+	Operation string                `json:"operation" example:"set_status"`
+	Succeeded int                   `json:"succeeded" example:"9"`
+	Failed    int                   `json:"failed" example:"1"`
+	Results   []*TaskBulkItemResult `json:"results"`
+}
@@ -0,0 +1,32 @@
+package tasks
+
+import (
+	"strconv"
+	"strings"
+)
+
+var exportHeader = []string{"task_id", "title", "brand_id", "brand", "platform_id", "platform", "due_date", "payment", "status"}
+
+func exportRecord(task *Tasks) []string {
+	return []string{
+		strconv.FormatInt(task.TaskID, 10),
+		exportText(task.Title),
+		strconv.FormatInt(task.BrandID, 10),
+		exportText(task.Brand),
+		strconv.FormatInt(task.PlatformID, 10),
+		exportText(task.Platform),
+		task.DueDate.Format("2006-01-02"),
+		task.Payment,
+		task.Status,
+	}
+}
+
+// exportText keeps spreadsheet applications from evaluating free text as a
+// formula by prefixing it with a quote.
+func exportText(value string) string {
+	if value != "" && strings.ContainsAny(value[:1], "=+-@\t\r") {
+		return "'" + value
+	}
+
+	return value
+}
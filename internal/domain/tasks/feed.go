@@ -0,0 +1,45 @@
+package tasks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+)
+
+const (
+	feedProductID = "-//sosmed-todolist//Tasks//EN"
+	feedUIDDomain = "sosmed-todolist"
+)
+
+func writeFeedHeader(iw *utils.ICalWriter) {
+	iw.Property("BEGIN", "VCALENDAR")
+	iw.Property("VERSION", "2.0")
+	iw.Text("PRODID", feedProductID)
+	iw.Property("CALSCALE", "GREGORIAN")
+	iw.Property("METHOD", "PUBLISH")
+	iw.Text("X-WR-CALNAME", "Tasks")
+}
+
+func writeFeedFooter(iw *utils.ICalWriter) {
+	iw.Property("END", "VCALENDAR")
+}
+
+// writeTaskEvent renders a task as an all-day event on its due date. The UID
+// only depends on the task ID so calendar clients update the event in place,
+// and DTSTAMP follows updated_at so a regenerated feed is identical unless
+// the task changed.
+func writeTaskEvent(iw *utils.ICalWriter, task *Tasks) {
+	iw.Property("BEGIN", "VEVENT")
+	iw.Text("UID", fmt.Sprintf("task-%d@%s", task.TaskID, feedUIDDomain))
+	iw.DateTime("DTSTAMP", task.UpdatedAt)
+	iw.DateTime("CREATED", task.CreatedAt)
+	iw.DateTime("LAST-MODIFIED", task.UpdatedAt)
+	iw.Date("DTSTART", task.DueDate)
+	iw.Date("DTEND", task.DueDate.AddDate(0, 0, 1))
+	iw.Text("SUMMARY", fmt.Sprintf("[%s] %s", task.Status, task.Title))
+	iw.Text("DESCRIPTION", fmt.Sprintf("Brand: %s\nPlatform: %s\nStatus: %s\nPayment: %s", task.Brand, task.Platform, task.Status, task.Payment))
+	iw.Property("CATEGORIES", strings.Join([]string{utils.ICalEscape(task.Brand), utils.ICalEscape(task.Platform), utils.ICalEscape(task.Status)}, ","))
+	iw.Property("TRANSP", "TRANSPARENT")
+	iw.Property("END", "VEVENT")
+}
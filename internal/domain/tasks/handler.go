@@ -2,6 +2,7 @@ package tasks
 
 import (
 	"context"
+	"io"
 	"net/http"
 
 	"github.com/agungramananda/sosmed-todolist/internal/utils"
@@ -9,19 +10,44 @@ import (
 )
 
 type GetAllTasksHandler func(context.Context, *TaskRequestQuery) (*ListofTasks, error)
+type GetMyTasksHandler func(context.Context, *TaskMineQuery) (*ListofTasks, error)
 type GetOneTasksHandler func(context.Context, *TaskRequestParams) (*TaskDetails, error)
 type CreateTasksHandler func(context.Context, *TaskRequestPayload) error
 type UpdateTasksHandler func(context.Context, *TaskRequestParams, *TaskRequestPayload) error
+type PatchTasksHandler func(context.Context, *TaskRequestParams, *TaskPatchPayload) error
 type DeleteTasksHandler func(context.Context, *TaskRequestParams) error
+type ReopenTasksHandler func(context.Context, *TaskRequestParams) error
+type UpdateTaskPlatformsHandler func(context.Context, *TaskPlatformParams, *TaskPlatformPayload) error
+type GetTaskStatusHistoryHandler func(context.Context, *TaskRequestParams) (*ListofTaskStatusHistory, error)
+type GetTaskCalendarHandler func(context.Context, *TaskCalendarQuery) (*TaskCalendar, error)
+type GetTaskFeedHandler func(context.Context, *TaskFeedQuery, io.Writer) error
+type ExportTasksHandler func(context.Context, *TaskExportQuery, io.Writer) error
+type BulkTasksHandler func(context.Context, *TaskBulkPayload) (*TaskBulkResult, error)
+type ImportTasksHandler func(context.Context, *TaskImportQuery, *TaskImportPayload) (*TaskImportResult, error)
 
 // Get All Tasks godoc
 //
 //	@Summary	Get all tasks
 //	@Tags		Task
 //	@Produce	json
-//	@Param		keyword	query		string	false	"Keyword to search"
-//	@Param		limit	query		int		false	"Number of entities per page"
-//	@Param		page	query		int		false	"Page number"
+//	@Param		keyword		query		string		false	"Keyword to search"
+//	@Param		status		query		[]string	false	"Filter by status"	collectionFormat(multi)	Enums(Pending, Completed, Scheduled)
+//	@Param		brand_id	query		[]int		false	"Filter by brand ID"	collectionFormat(multi)
+//	@Param		platform_id	query		[]int		false	"Filter by platform ID, any platform of a task matches"	collectionFormat(multi)
+//	@Param		due_from	query		string		false	"Earliest due date (YYYY-MM-DD)"
+//	@Param		due_to		query		string		false	"Latest due date (YYYY-MM-DD)"
+//	@Param		min_payment	query		int			false	"Minimum payment"
+//	@Param		max_payment	query		int			false	"Maximum payment"
+//	@Param		hashtag		query		[]string	false	"Filter by hashtag, matches tasks with any of them"	collectionFormat(multi)
+//	@Param		overdue		query		bool		false	"Filter by whether the task is past its due date and not completed, refreshed every 5 minutes"
+//	@Param		incomplete_checklist	query		bool		false	"Filter by whether the task has open checklist items"
+//	@Param		tag			query		[]string	false	"Filter by tag name, repeatable"	collectionFormat(multi)
+//	@Param		tag_match	query		string		false	"Whether a task needs any or all of the tags (default any)"	Enums(any, all)
+//	@Param		assignee	query		string		false	"Filter by assignee, me, unassigned or a user ID"
+//	@Param		sort		query		string		false	"Comma separated sort keys, prefix with - for descending (task_id, title, brand, platform, due_date, payment, status, created_at)"
+//	@Param		cursor		query		string		false	"Cursor from a previous next_cursor or prev_cursor, page is ignored when set"
+//	@Param		limit		query		int			false	"Number of entities per page"
+//	@Param		page		query		int			false	"Page number"
 //	@Success	200		{object}	ListofTasks	"Successfully fetched all tasks"
 //	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
 //	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
@@ -48,6 +74,40 @@ func HandleGetAllTasks(handler GetAllTasksHandler) echo.HandlerFunc {
 	}
 }
 
+// Get My Tasks godoc
+//
+//	@Summary	Get the open tasks assigned to the caller
+//	@Description	Tasks that are not completed yet, in the current workspace, the ones due first at the top.
+//	@Tags		Task
+//	@Produce	json
+//	@Param		limit	query		int		false	"Number of entities per page"
+//	@Param		page	query		int		false	"Page number"
+//	@Success	200		{object}	ListofTasks	"Successfully fetched my tasks"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/me/tasks [get]
+func HandleGetMyTasks(handler GetMyTasksHandler) echo.HandlerFunc {
+	return func(c echo.Context) (err error) {
+		ctx := c.Request().Context()
+		query := &TaskMineQuery{}
+
+		if err = c.Bind(query); err != nil {
+			return err
+		}
+
+		if err = c.Validate(query); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "My tasks fetched successfully")
+	}
+}
+
 // Get One Task godoc
 //
 //	@Summary	Get a single task by ID
@@ -90,6 +150,7 @@ func HandleGetOneTasks(handler GetOneTasksHandler) echo.HandlerFunc {
 //	@Param		body	body	TaskRequestPayload	true	"Task details"
 //	@Success	201		{object}	httpres.BaseResponse	"Task successfully created"
 //	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not create tasks"
 //	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
 //	@Router		/tasks [post]
 func HandleCreateTasks(handler CreateTasksHandler) echo.HandlerFunc {
@@ -124,6 +185,7 @@ func HandleCreateTasks(handler CreateTasksHandler) echo.HandlerFunc {
 //	@Param		body	body	TaskRequestPayload	true	"Updated task details"
 //	@Success	200		{object}	httpres.BaseResponse	"Task updated successfully"
 //	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not change the task"
 //	@Failure	404		{object}	httpres.ErrorResponse	"Task not found"
 //	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
 //	@Router		/tasks/{id} [put]
@@ -157,6 +219,52 @@ func HandleUpdateTasks(handler UpdateTasksHandler) echo.HandlerFunc {
 	}
 }
 
+// Patch Task godoc
+//
+//	@Summary	Partially update an existing task
+//	@Tags		Task
+//	@Accept		json
+//	@Accept		application/merge-patch+json
+//	@Produce	json
+//	@Param		id		path	string				true	"Task ID"
+//	@Param		body	body	TaskPatchPayload	true	"Task fields to change"
+//	@Success	200		{object}	httpres.BaseResponse	"Task updated successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not change the task"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Task not found"
+//	@Failure	415		{object}	httpres.ErrorResponse	"Unsupported media type"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{id} [patch]
+func HandlePatchTasks(handler PatchTasksHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &TaskRequestParams{}
+		payload := &TaskPatchPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := utils.BindMergePatch(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Task updated successfully")
+	}
+}
+
 // Delete Task godoc
 //
 //	@Summary	Delete a task by ID
@@ -165,6 +273,7 @@ func HandleUpdateTasks(handler UpdateTasksHandler) echo.HandlerFunc {
 //	@Param		id	path	string	true	"Task ID"
 //	@Success	200		{object}	httpres.BaseResponse	"Task deleted successfully"
 //	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not delete tasks"
 //	@Failure	404		{object}	httpres.ErrorResponse	"Task not found"
 //	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
 //	@Router		/tasks/{id} [delete]
@@ -187,4 +296,310 @@ func HandleDeleteTasks(handler DeleteTasksHandler) echo.HandlerFunc {
 
 		return utils.WriteResponse(c, http.StatusOK, nil, "Task deleted successfully")
 	}
-}
\ No newline at end of file
+}
+
+// Reopen Task godoc
+//
+//	@Summary	Reopen a completed task, moving it back to Pending
+//	@Tags		Task
+//	@Produce	json
+//	@Param		id	path	string	true	"Task ID"
+//	@Success	200		{object}	httpres.BaseResponse	"Task reopened successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not change the task"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Task not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{id}/reopen [post]
+func HandleReopenTasks(handler ReopenTasksHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &TaskRequestParams{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Task reopened successfully")
+	}
+}
+
+// Update Task Platform godoc
+//
+//	@Summary		Set the status and payment of a task on one of its platforms
+//	@Description	The status follows the same transitions as the status of the task and is added to its status history. The status on the primary platform is the status of the task and changes it too.
+//	@Tags			Task
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string					true	"Task ID"
+//	@Param			platform_id	path		string					true	"Platform ID"
+//	@Param			body		body		TaskPlatformPayload		true	"Status and payment on the platform"
+//	@Success		200			{object}	httpres.BaseResponse	"Task platform updated successfully"
+//	@Failure		400			{object}	httpres.ErrorResponse	"Bad request or transition not allowed"
+//	@Failure		403			{object}	httpres.ErrorResponse	"Role may not change the task"
+//	@Failure		404			{object}	httpres.ErrorResponse	"Task or task platform not found"
+//	@Failure		500			{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/tasks/{id}/platforms/{platform_id} [put]
+func HandleUpdateTaskPlatforms(handler UpdateTaskPlatformsHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &TaskPlatformParams{}
+		payload := &TaskPlatformPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Task platform updated successfully")
+	}
+}
+
+// Get Task Status History godoc
+//
+//	@Summary	Get the status transitions of a task
+//	@Tags		Task
+//	@Produce	json
+//	@Param		id	path	string	true	"Task ID"
+//	@Success	200		{object}	ListofTaskStatusHistory	"Successfully fetched the task status history"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Task not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/tasks/{id}/history [get]
+func HandleGetTaskStatusHistory(handler GetTaskStatusHistoryHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &TaskRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "Task status history fetched successfully")
+	}
+}
+
+// Get Task Calendar godoc
+//
+//	@Summary		Get tasks grouped into day, week or month buckets by due date
+//	@Description	Every bucket between from and to is returned, including empty ones. Weeks start on Monday and the first and last buckets are clipped to the range, which may span at most 366 days.
+//	@Tags			Task
+//	@Produce		json
+//	@Param			from		query		string		true	"First due date (YYYY-MM-DD)"
+//	@Param			to			query		string		true	"Last due date (YYYY-MM-DD)"
+//	@Param			granularity	query		string		false	"Bucket size, defaults to day"	Enums(day, week, month)
+//	@Param			status		query		[]string	false	"Filter by status"	collectionFormat(multi)	Enums(Pending, Completed, Scheduled)
+//	@Param			brand_id	query		[]int		false	"Filter by brand ID"	collectionFormat(multi)
+//	@Param			platform_id	query		[]int		false	"Filter by platform ID, any platform of a task matches"	collectionFormat(multi)
+//	@Success		200		{object}	TaskCalendar	"Successfully fetched the task calendar"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/tasks/calendar [get]
+func HandleGetTaskCalendar(handler GetTaskCalendarHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		query := &TaskCalendarQuery{}
+
+		if err := c.Bind(query); err != nil {
+			return err
+		}
+
+		if err := c.Validate(query); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, query)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "Task calendar fetched successfully")
+	}
+}
+
+// Get Task Feed godoc
+//
+//	@Summary		Get tasks as an iCalendar feed
+//	@Description	Returns an RFC 5545 calendar with one all-day event per task on its due date, meant to be subscribed to from a calendar app. Event UIDs are stable so subscribed clients update events in place.
+//	@Tags			Task
+//	@Produce		text/calendar
+//	@Param			brand_id	query	[]int	false	"Filter by brand ID"	collectionFormat(multi)
+//	@Param			platform_id	query	[]int	false	"Filter by platform ID, any platform of a task matches"	collectionFormat(multi)
+//	@Success		200		{string}	string	"iCalendar feed"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/tasks/feed.ics [get]
+func HandleGetTaskFeed(handler GetTaskFeedHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		query := &TaskFeedQuery{}
+
+		if err := c.Bind(query); err != nil {
+			return err
+		}
+
+		if err := c.Validate(query); err != nil {
+			return err
+		}
+
+		return utils.WriteStream(c, utils.MIMETextCalendar, "tasks.ics", "inline", func(w io.Writer) error {
+			return handler(ctx, query, w)
+		})
+	}
+}
+
+// Export Tasks godoc
+//
+//	@Summary		Export tasks as CSV
+//	@Description	Streams every task matching the filters, without the page size limit of the list endpoint.
+//	@Tags			Task
+//	@Produce		text/csv
+//	@Param			keyword		query		string		false	"Keyword to search"
+//	@Param			status		query		[]string	false	"Filter by status"	collectionFormat(multi)	Enums(Pending, Completed, Scheduled)
+//	@Param			brand_id	query		[]int		false	"Filter by brand ID"	collectionFormat(multi)
+//	@Param			platform_id	query		[]int		false	"Filter by platform ID, any platform of a task matches"	collectionFormat(multi)
+//	@Param			due_from	query		string		false	"Earliest due date (YYYY-MM-DD)"
+//	@Param			due_to		query		string		false	"Latest due date (YYYY-MM-DD)"
+//	@Param			min_payment	query		int			false	"Minimum payment"
+//	@Param			max_payment	query		int			false	"Maximum payment"
+//	@Param			hashtag		query		[]string	false	"Filter by hashtag, matches tasks with any of them"	collectionFormat(multi)
+//	@Param			overdue		query		bool		false	"Filter by whether the task is past its due date and not completed, refreshed every 5 minutes"
+//	@Param			incomplete_checklist	query		bool		false	"Filter by whether the task has open checklist items"
+//	@Param			tag			query		[]string	false	"Filter by tag name, repeatable"	collectionFormat(multi)
+//	@Param			tag_match	query		string		false	"Whether a task needs any or all of the tags (default any)"	Enums(any, all)
+//	@Param			assignee	query		string		false	"Filter by assignee, me, unassigned or a user ID"
+//	@Param			sort		query		string		false	"Comma separated sort keys, prefix with - for descending (task_id, title, brand, platform, due_date, payment, status, created_at)"
+//	@Success		200		{string}	string	"CSV with a header row"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/tasks/export.csv [get]
+func HandleExportTasks(handler ExportTasksHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		query := &TaskExportQuery{}
+
+		if err := c.Bind(query); err != nil {
+			return err
+		}
+
+		if err := c.Validate(query); err != nil {
+			return err
+		}
+
+		return utils.WriteStream(c, utils.MIMETextCSV, "tasks.csv", "attachment", func(w io.Writer) error {
+			return handler(ctx, query, w)
+		})
+	}
+}
+
+// Import Tasks godoc
+//
+//	@Summary		Import tasks from a CSV file
+//	@Description	The header row names the columns title, brand, platform, due_date, payment, status and optionally caption, hashtags (separated by spaces or commas) and cta_url. Brands and platforms are given by name or by ID, the brand_id and platform_id columns of an export are accepted too and its task_id column is ignored, so an export can be imported back. Every row is validated like a created task, then the valid rows are created in one transaction and invalid rows are reported. With dry_run=true nothing is created.
+//	@Tags			Task
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			dry_run	query		bool	false	"Only validate the rows"
+//	@Param			file	formData	file	true	"CSV file, at most 5 MB and 1000 rows"
+//	@Success		200		{object}	TaskImportResult	"Rows validated (dry run)"
+//	@Success		201		{object}	TaskImportResult	"Valid rows imported"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Role may not create tasks"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/tasks/import [post]
+func HandleImportTasks(handler ImportTasksHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		query := &TaskImportQuery{}
+		payload := &TaskImportPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindQueryParams(c, query); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, query, payload)
+		if err != nil {
+			return err
+		}
+
+		if query.DryRun {
+			return utils.WriteResponse(c, http.StatusOK, data, "Import file validated successfully")
+		}
+
+		return utils.WriteResponse(c, http.StatusCreated, data, "Tasks imported successfully")
+	}
+}
+
+// Bulk Tasks godoc
+//
+//	@Summary		Apply one operation to many tasks
+//	@Description	Sets the status (status), shifts the due date by a number of days (days), moves the tasks to another platform (platform_id) or deletes them. All tasks are changed in one transaction, a task that cannot be changed is reported in its result and left as it was.
+//	@Tags			Task
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		TaskBulkPayload	true	"Tasks and operation"
+//	@Success		200		{object}	TaskBulkResult	"Operation applied"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Role may not run the operation"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/tasks/bulk [post]
+func HandleBulkTasks(handler BulkTasksHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		payload := &TaskBulkPayload{}
+
+		if err := c.Bind(payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, payload)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "Bulk operation applied successfully")
+	}
+}
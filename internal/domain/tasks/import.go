@@ -0,0 +1,170 @@
+package tasks
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+)
+
+const (
+	maxImportSizeMB = 5
+	maxImportRows   = 1000
+)
+
+var (
+	importColumns         = []string{"task_id", "title", "brand", "brand_id", "platform", "platform_id", "due_date", "payment", "status", "caption", "hashtags", "cta_url"}
+	importRequiredColumns = []string{"title", "due_date", "payment", "status"}
+
+	// importIDColumns give the brand or platform by ID, as an export does next
+	// to its name. A row with both uses the ID.
+	importIDColumns = map[string]string{
+		"brand_id":    "brand",
+		"platform_id": "platform",
+	}
+)
+
+type importRow struct {
+	Line   int
+	Values map[string]string
+	Err    error
+}
+
+// readImportRows reads a CSV with a header row. Errors about the file as a
+// whole are returned, errors about a single row are kept on the row.
+func readImportRows(r io.Reader) ([]*importRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, exceptions.NewInvariantError("file is empty")
+	} else if err != nil {
+		return nil, exceptions.NewInvariantError(fmt.Sprintf("file is not a valid CSV: %s", err))
+	}
+
+	columns, err := importHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []*importRow{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			// a broken quote can swallow the rest of the file, so give up
+			return nil, exceptions.NewInvariantError(fmt.Sprintf("file is not a valid CSV: %s", err))
+		} else if err != nil {
+			return nil, err
+		}
+
+		if len(rows) == maxImportRows {
+			return nil, exceptions.NewInvariantError(fmt.Sprintf("file must have at most %d rows", maxImportRows))
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &importRow{Line: line, Values: map[string]string{}}
+
+		if len(record) != len(columns) {
+			row.Err = fmt.Errorf("row has %d columns, the header has %d", len(record), len(columns))
+		} else {
+			for i, column := range columns {
+				row.Values[column] = importText(strings.TrimSpace(record[i]))
+			}
+
+			// the task_id of an export is ignored, an import always creates
+			// new tasks
+			delete(row.Values, "task_id")
+
+			for idColumn, column := range importIDColumns {
+				if id := row.Values[idColumn]; id != "" {
+					row.Values[column] = id
+				}
+				delete(row.Values, idColumn)
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func importHeader(header []string) ([]string, error) {
+	columns := make([]string, 0, len(header))
+	seen := map[string]bool{}
+
+	for i, name := range header {
+		// spreadsheet applications often start the file with a byte order mark
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+
+		column := strings.ToLower(strings.TrimSpace(name))
+
+		if !slices.Contains(importColumns, column) {
+			return nil, exceptions.NewInvariantError(fmt.Sprintf("unknown column %s", name))
+		}
+		if seen[column] {
+			return nil, exceptions.NewInvariantError(fmt.Sprintf("column %s is given more than once", column))
+		}
+		seen[column] = true
+
+		columns = append(columns, column)
+	}
+
+	for _, column := range importRequiredColumns {
+		if !seen[column] {
+			return nil, exceptions.NewInvariantError(fmt.Sprintf("column %s is required", column))
+		}
+	}
+
+	for idColumn, column := range importIDColumns {
+		if !seen[column] && !seen[idColumn] {
+			return nil, exceptions.NewInvariantError(fmt.Sprintf("column %s or %s is required", column, idColumn))
+		}
+	}
+
+	return columns, nil
+}
+
+// importText removes the quote exportText puts in front of a value that
+// would otherwise be read as a formula.
+func importText(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsAny(value[1:2], "=+-@\t\r") {
+		return value[1:]
+	}
+
+	return value
+}
+
+// parsePayment accepts a whole amount, with or without a fraction of zeros
+// like the 150000.00 of an export.
+func parsePayment(value string) (int64, error) {
+	whole, fraction, found := strings.Cut(value, ".")
+	if found && (fraction == "" || strings.Trim(fraction, "0") != "") {
+		return 0, fmt.Errorf("payment must be a whole number")
+	}
+
+	return strconv.ParseInt(whole, 10, 64)
+}
+
+// splitHashtags accepts hashtags separated by spaces or commas.
+func splitHashtags(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	})
+}
+
@@ -1,6 +1,11 @@
 package tasks
 
-import "time"
+import (
+	"database/sql"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+)
 
 type Tasks struct {
 	TaskID 		int64     	`db:"task_id"`
@@ -12,4 +17,57 @@ type Tasks struct {
 	DueDate 	time.Time 	`db:"due_date"`
 	Payment 	string 	 	`db:"payment"`
 	Status 		string		`db:"status"`
-}
\ No newline at end of file
+	Caption		string				`db:"caption"`
+	Hashtags	utils.StringArray	`db:"hashtags"`
+	CTAURL		string				`db:"cta_url"`
+	Overdue		bool		`db:"overdue"`
+	CreatedBy	sql.NullInt64	`db:"created_by"`
+	AssigneeID	sql.NullInt64	`db:"assignee_id"`
+	ReviewerID	sql.NullInt64	`db:"reviewer_id"`
+	CreatedAt	time.Time	`db:"created_at"`
+	UpdatedAt	time.Time	`db:"updated_at"`
+}
+
+type TaskPlatforms struct {
+	TaskID		int64			`db:"task_id"`
+	PlatformID	int64			`db:"platform_id"`
+	Platform	string			`db:"platform"`
+	Primary		bool			`db:"is_primary"`
+	Status		string			`db:"status"`
+	Payment		sql.NullString	`db:"payment"`
+}
+
+type TaskTags struct {
+	TaskID		int64	`db:"task_id"`
+	TagID		int64	`db:"tag_id"`
+	Tag			string	`db:"tag"`
+}
+
+type TaskProgress struct {
+	TaskID		int64	`db:"task_id"`
+	Total		int		`db:"total"`
+	Completed	int		`db:"completed"`
+}
+
+type TaskCommentCount struct {
+	TaskID		int64	`db:"task_id"`
+	Comments	int		`db:"comments"`
+}
+
+type TaskStatusHistory struct {
+	HistoryID	int64			`db:"history_id"`
+	TaskID		int64			`db:"task_id"`
+	PlatformID	sql.NullInt64	`db:"platform_id"`
+	FromStatus	sql.NullString	`db:"from_status"`
+	ToStatus	string			`db:"to_status"`
+	ChangedAt	time.Time		`db:"changed_at"`
+}
+
+type TaskCalendarSummary struct {
+	Bucket		time.Time	`db:"bucket"`
+	Total		int64		`db:"total"`
+	Pending		int64		`db:"pending"`
+	Completed	int64		`db:"completed"`
+	Scheduled	int64		`db:"scheduled"`
+	Payment		string		`db:"payment"`
+}
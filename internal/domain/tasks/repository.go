@@ -3,6 +3,11 @@ package tasks
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
@@ -12,13 +17,78 @@ import (
 
 var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 
+var tasksSortColumns = map[string]string{
+	"task_id":    "t.task_id",
+	"title":      "t.title",
+	"brand":      "b.brand",
+	"platform":   "p.platform",
+	"due_date":   "t.due_date",
+	"payment":    "t.payment",
+	"status":     "t.status",
+	"created_at": "t.created_at",
+}
+
+// tasksSortValue returns the value of a sortable column for a row, as stored
+// in a pagination cursor.
+func tasksSortValue(task *Tasks, key string) string {
+	switch key {
+	case "task_id":
+		return strconv.FormatInt(task.TaskID, 10)
+	case "title":
+		return task.Title
+	case "brand":
+		return task.Brand
+	case "platform":
+		return task.Platform
+	case "due_date":
+		return task.DueDate.Format(utils.CursorTimeFormat)
+	case "payment":
+		return task.Payment
+	case "status":
+		return task.Status
+	case "created_at":
+		return task.CreatedAt.Format(utils.CursorTimeFormat)
+	}
+
+	return ""
+}
+
+var tasksColumns = []string{"t.task_id", "t.title", "t.brand_id", "b.brand", "t.platform_id", "p.platform", "t.due_date", "t.payment", "t.status", "t.caption", "t.hashtags", "t.cta_url", "t.overdue", "t.created_by", "t.assignee_id", "t.reviewer_id", "t.created_at", "t.updated_at"}
+
+// selectTasks starts a query over tasks joined with their brand and platform,
+// which every read needs for the names and the soft-delete filters.
+func selectTasks(columns ...string) squirrel.SelectBuilder {
+	return pgSquirell.Select(columns...).
+		From("tasks t").
+		LeftJoin("brands b on t.brand_id=b.brand_id").
+		LeftJoin("platforms p on t.platform_id=p.platform_id")
+}
+
 type TasksRepository interface {
-	GetAll(context.Context, *TaskRequestQuery) ([]*Tasks, error)
-	Count(context.Context, *TaskRequestQuery) (uint64, error)
-	GetByID(context.Context, *TaskRequestParams) (*Tasks, error)
-	Add(context.Context, *TaskRequestPayload) error
-	Update(context.Context, *TaskRequestPayload, *TaskRequestParams) error
-	Delete(context.Context, *TaskRequestParams) error
+	GetAll(context.Context, int64, *TaskRequestQuery) ([]*Tasks, error)
+	Count(context.Context, int64, *TaskRequestQuery) (uint64, error)
+	GetByID(context.Context, int64, *TaskRequestParams) (*Tasks, error)
+	GetByIDs(context.Context, int64, []int64) ([]*Tasks, error)
+	GetOwners(context.Context, int64, string) (int64, int64, error)
+	Add(context.Context, int64, *TaskRequestPayload) (int64, error)
+	AddMany(context.Context, int64, []*TaskRequestPayload) ([]int64, error)
+	FindBrands(context.Context, int64, []string) (map[string][]int64, error)
+	FindPlatforms(context.Context, int64, []string) (map[string][]int64, error)
+	Update(context.Context, int64, *TaskRequestPayload, *TaskRequestParams) error
+	Patch(context.Context, int64, *TaskPatchPayload, *TaskRequestParams) error
+	Delete(context.Context, int64, *TaskRequestParams) error
+	Reopen(context.Context, int64, *TaskRequestParams) error
+	GetPlatforms(context.Context, []int64) ([]*TaskPlatforms, error)
+	GetTags(context.Context, []int64) ([]*TaskTags, error)
+	GetProgress(context.Context, []int64) ([]*TaskProgress, error)
+	GetCommentCounts(context.Context, []int64) ([]*TaskCommentCount, error)
+	UpdatePlatform(context.Context, int64, *TaskPlatformParams, *TaskPlatformPayload) error
+	GetStatusHistory(context.Context, int64, *TaskRequestParams) ([]*TaskStatusHistory, error)
+	GetCalendar(context.Context, int64, *TaskCalendarQuery) ([]*Tasks, error)
+	GetCalendarSummary(context.Context, int64, *TaskCalendarQuery) ([]*TaskCalendarSummary, error)
+	ForEach(context.Context, int64, *TaskExportQuery, func(*Tasks) error) error
+	Bulk(context.Context, int64, *TaskBulkPayload) ([]*TaskBulkItemResult, error)
+	SweepOverdue(context.Context) error
 }
 
 type tasksRepository struct {
@@ -31,43 +101,58 @@ func NewRepository(db *sqlx.DB) TasksRepository {
 	}
 }
 
-func (r *tasksRepository) GetAll(ctx context.Context, query *TaskRequestQuery) (resp []*Tasks, err error) {
-	keyword := query.Keyword
-	utils.KeywordHelper(&keyword)
+func (r *tasksRepository) GetAll(ctx context.Context, workspaceID int64, query *TaskRequestQuery) (resp []*Tasks, err error) {
+	resp = []*Tasks{}
+
+	sortFields, err := utils.ParseSort(query.Sort, tasksSortColumns, "task_id")
+	if err != nil {
+		return resp, err
+	}
 
-	stmt, args, _ := pgSquirell.Select("t.task_id", "t.title", "t.brand_id", "b.brand", "t.platform_id", "p.platform", "t.due_date","t.payment","t.status").
-						From("tasks t").
-						LeftJoin("brands b on t.brand_id=b.brand_id").
-						LeftJoin("platforms p on t.platform_id=p.platform_id").
-						Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil}, squirrel.ILike{"t.title": keyword}, squirrel.Eq{"b.deleted_at":nil}, squirrel.Eq{"p.deleted_at":nil}}).
-						Limit(query.Limit).Offset((query.Page - 1) * query.Limit).ToSql()
+	builder := selectTasks(tasksColumns...).Where(tasksFilter(workspaceID, &query.TaskFilter))
 
-	resp = []*Tasks{}
+	// one extra row is fetched so the service can tell whether more rows follow
+	if query.Cursor != "" {
+		cursor, err := utils.DecodeCursor(query.Cursor, sortFields)
+		if err != nil {
+			return resp, err
+		}
+		if cursor.Backward {
+			sortFields = utils.ReverseSort(sortFields)
+		}
+
+		builder = builder.Where(utils.KeysetPredicate(sortFields, cursor.Values)).
+						OrderBy(utils.OrderByClauses(sortFields)...).
+						Limit(query.Limit + 1)
+	} else {
+		builder = builder.OrderBy(utils.OrderByClauses(sortFields)...).
+						Limit(query.Limit + 1).Offset((query.Page - 1) * query.Limit)
+	}
+
+	stmt, args, _ := builder.ToSql()
 
 	rows, err := r.db.QueryContext(ctx, stmt, args...)
 	if err != nil {
 		return resp, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		col := &Tasks{}
 
-		if err = rows.Scan(&col.TaskID, &col.Title, &col.BrandID, &col.Brand, &col.PlatformID, &col.Platform, &col.DueDate, &col.Payment, &col.Status); err != nil {
+		if err = rows.Scan(&col.TaskID, &col.Title, &col.BrandID, &col.Brand, &col.PlatformID, &col.Platform, &col.DueDate, &col.Payment, &col.Status, &col.Caption, &col.Hashtags, &col.CTAURL, &col.Overdue, &col.CreatedBy, &col.AssigneeID, &col.ReviewerID, &col.CreatedAt, &col.UpdatedAt); err != nil {
 			return resp, err
 		}
 
 		resp = append(resp, col)
 	}
 
-	return resp, nil
+	return resp, rows.Err()
 }
 
-func (r *tasksRepository) GetByID(ctx context.Context, params *TaskRequestParams) (resp *Tasks, err error) {
-	stmt, args, _ := pgSquirell.Select("t.task_id", "t.title", "t.brand_id", "b.brand", "t.platform_id", "p.platform", "t.due_date","t.payment","t.status").
-						From("tasks t").
-						LeftJoin("brands b on t.brand_id=b.brand_id").
-						LeftJoin("platforms p on t.platform_id=p.platform_id").
-						Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil}, squirrel.Eq{"t.task_id": params.TaskID}, squirrel.Eq{"b.deleted_at":nil}, squirrel.Eq{"p.deleted_at":nil}}).
+func (r *tasksRepository) GetByID(ctx context.Context, workspaceID int64, params *TaskRequestParams) (resp *Tasks, err error) {
+	stmt, args, _ := selectTasks(tasksColumns...).
+						Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil, "t.workspace_id": workspaceID}, squirrel.Eq{"t.task_id": params.TaskID}, squirrel.Eq{"b.deleted_at":nil}, squirrel.Eq{"p.deleted_at":nil}}).
 						ToSql()
 
 	resp = &Tasks{}
@@ -82,20 +167,12 @@ func (r *tasksRepository) GetByID(ctx context.Context, params *TaskRequestParams
 	return resp, nil
 }
 
-func (r *tasksRepository) Count(ctx context.Context, query *TaskRequestQuery) (resp uint64, err error) {
-	keyword := query.Keyword
-	utils.KeywordHelper(&keyword)
-
-	stmt, args, _ := pgSquirell.Select("count(t.task_id)").
-						From("tasks t").
-						LeftJoin("brands b on t.brand_id=b.brand_id").
-						LeftJoin("platforms p on t.platform_id=p.platform_id").
-						Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil}, squirrel.Eq{"b.deleted_at":nil}, squirrel.Eq{"p.deleted_at":nil}}).
-						ToSql()
+func (r *tasksRepository) Count(ctx context.Context, workspaceID int64, query *TaskRequestQuery) (resp uint64, err error) {
+	stmt, args, _ := selectTasks("count(t.task_id)").Where(tasksFilter(workspaceID, &query.TaskFilter)).ToSql()
 
 	err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&resp)
 	if err != nil && err != sql.ErrNoRows {
-		return resp, nil
+		return resp, err
 	} else if err == sql.ErrNoRows {
 		return 0, nil
 	}
@@ -103,49 +180,210 @@ func (r *tasksRepository) Count(ctx context.Context, query *TaskRequestQuery) (r
 	return resp, nil
 }
 
-func (r *tasksRepository) Add(ctx context.Context, payload *TaskRequestPayload) (err error) {
+// GetByIDs returns the tasks among taskIDs that still exist, in no
+// particular order.
+func (r *tasksRepository) GetByIDs(ctx context.Context, workspaceID int64, taskIDs []int64) (resp []*Tasks, err error) {
+	resp = []*Tasks{}
+	if len(taskIDs) == 0 {
+		return resp, nil
+	}
+
+	stmt, args, _ := selectTasks(tasksColumns...).
+						Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil, "t.workspace_id": workspaceID}, squirrel.Eq{"t.task_id": taskIDs}, squirrel.Eq{"b.deleted_at":nil}, squirrel.Eq{"p.deleted_at":nil}}).
+						ToSql()
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// GetOwners looks up who created a task and who it is assigned to, it is the
+// auth.TaskOwners of the services of the checklist, comments, attachments and
+// reminders of a task.
+func (r *tasksRepository) GetOwners(ctx context.Context, workspaceID int64, taskID string) (createdBy int64, assigneeID int64, err error) {
+	var creator, assignee sql.NullInt64
+
+	stmt, args, _ := selectTasks("t.created_by", "t.assignee_id").
+						Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil, "t.workspace_id": workspaceID}, squirrel.Eq{"b.deleted_at": nil}, squirrel.Eq{"p.deleted_at": nil}, squirrel.Eq{"t.task_id": taskID}}).
+						ToSql()
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&creator, &assignee)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, 0, err
+	} else if err == sql.ErrNoRows {
+		return 0, 0, exceptions.NewNotFoundError("tasks not found")
+	}
+
+	return creator.Int64, assignee.Int64, nil
+}
+
+func (r *tasksRepository) Add(ctx context.Context, workspaceID int64, payload *TaskRequestPayload) (taskID int64, err error) {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	defer tx.Rollback()
+
+	if taskID, err = insertTask(ctx, tx, workspaceID, payload); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return taskID, nil
+}
+
+// AddMany inserts all tasks in one transaction, either every task is created
+// or none is.
+func (r *tasksRepository) AddMany(ctx context.Context, workspaceID int64, payloads []*TaskRequestPayload) (taskIDs []int64, err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
 	}
 
 	defer tx.Rollback()
 
+	taskIDs = []int64{}
+	for _, payload := range payloads {
+		taskID, err := insertTask(ctx, tx, workspaceID, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return taskIDs, nil
+}
+
+// FindBrands maps the given brand names (lowercased) and IDs to the IDs of
+// the brands they refer to. References that match nothing are left out.
+func (r *tasksRepository) FindBrands(ctx context.Context, workspaceID int64, refs []string) (map[string][]int64, error) {
+	return r.findReferences(ctx, workspaceID, "brands", "brand_id", "brand", refs)
+}
+
+// FindPlatforms is FindBrands for platforms.
+func (r *tasksRepository) FindPlatforms(ctx context.Context, workspaceID int64, refs []string) (map[string][]int64, error) {
+	return r.findReferences(ctx, workspaceID, "platforms", "platform_id", "platform", refs)
+}
+
+func (r *tasksRepository) findReferences(ctx context.Context, workspaceID int64, table string, idColumn string, nameColumn string, refs []string) (resp map[string][]int64, err error) {
+	resp = map[string][]int64{}
+
+	names := []string{}
+	ids := []int64{}
+	for _, ref := range refs {
+		if id, err := strconv.ParseInt(ref, 10, 64); err == nil {
+			ids = append(ids, id)
+		} else {
+			names = append(names, strings.ToLower(ref))
+		}
+	}
+
+	if len(names) == 0 && len(ids) == 0 {
+		return resp, nil
+	}
+
+	stmt, args, _ := pgSquirell.Select(idColumn, "lower("+nameColumn+")").
+						From(table).
+						Where(squirrel.And{
+							squirrel.Eq{"deleted_at": nil, "workspace_id": workspaceID},
+							squirrel.Or{squirrel.Eq{idColumn: ids}, squirrel.Eq{"lower(" + nameColumn + ")": names}},
+						}).
+						ToSql()
+
+	rows, err := r.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var name string
+
+		if err = rows.Scan(&id, &name); err != nil {
+			return resp, err
+		}
+
+		resp[strconv.FormatInt(id, 10)] = []int64{id}
+		resp[name] = append(resp[name], id)
+	}
+
+	return resp, rows.Err()
+}
+
+// insertTask creates a task after checking its brand and platform exist, and
+// records its initial status.
+func insertTask(ctx context.Context, tx *sqlx.Tx, workspaceID int64, payload *TaskRequestPayload) (taskID int64, err error) {
 	var stmt string
 	var args []any
 	var count int64
 
-	stmt, args, _ = pgSquirell.Select("count(*)").From("brands").Where(squirrel.Eq{"brand_id": payload.BrandID, "deleted_at": nil}).ToSql()
+	stmt, args, _ = pgSquirell.Select("count(*)").From("brands").Where(squirrel.Eq{"brand_id": payload.BrandID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
 	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
 	if err != nil {
-		return err
+		return 0, err
 	} else if count == 0 {
-		return exceptions.NewInvariantError("brand_id does not exist")
+		return 0, exceptions.NewInvariantError("brand_id does not exist")
 	}
 
-	stmt, args, _ = pgSquirell.Select("count(*)").From("platforms").Where(squirrel.Eq{"platform_id": payload.PlatformID, "deleted_at": nil}).ToSql()
+	stmt, args, _ = pgSquirell.Select("count(*)").From("platforms").Where(squirrel.Eq{"platform_id": payload.PlatformID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
 	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
 	if err != nil {
-		return err
+		return 0, err
 	} else if count == 0 {
-		return exceptions.NewInvariantError("platform_id does not exist")
+		return 0, exceptions.NewInvariantError("platform_id does not exist")
+	}
+
+	if err = checkMember(ctx, tx, workspaceID, "assignee_id", payload.AssigneeID); err != nil {
+		return 0, err
+	}
+
+	if err = checkMember(ctx, tx, workspaceID, "reviewer_id", payload.ReviewerID); err != nil {
+		return 0, err
 	}
 
-	stmt, args, _ = pgSquirell.Insert("tasks").Columns("title", "brand_id", "platform_id", "due_date", "payment", "status").Values(payload.Title, payload.BrandID, payload.PlatformID, payload.DueDate, payload.Payment, payload.Status).ToSql()
+	stmt, args, _ = pgSquirell.Insert("tasks").Columns("workspace_id", "title", "brand_id", "platform_id", "due_date", "payment", "status", "caption", "hashtags", "cta_url", "created_by", "assignee_id", "reviewer_id").Values(workspaceID, payload.Title, payload.BrandID, payload.PlatformID, payload.DueDate, payload.Payment, payload.Status, payload.Caption, payload.Hashtags, payload.CTAURL, payload.CreatedBy, nullID(payload.AssigneeID), nullID(payload.ReviewerID)).Suffix("RETURNING task_id").ToSql()
 
-	_, err = tx.ExecContext(ctx, stmt, args...)
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&taskID)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	if err = tx.Commit(); err != nil {
-		return err
+	if payload.PlatformIDs != nil {
+		if err = setPlatforms(ctx, tx, workspaceID, taskID, payload.PlatformIDs); err != nil {
+			return 0, err
+		}
 	}
 
-	return nil
+	if payload.Tags != nil {
+		if err = setTags(ctx, tx, workspaceID, taskID, payload.Tags); err != nil {
+			return 0, err
+		}
+	}
+
+	stmt, args, _ = pgSquirell.Insert("task_status_history").Columns("task_id", "to_status").Values(taskID, payload.Status).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return taskID, nil
 }
 
-func (r *tasksRepository) Update(ctx context.Context, payload *TaskRequestPayload, params *TaskRequestParams) (err error) {
+func (r *tasksRepository) Update(ctx context.Context, workspaceID int64, payload *TaskRequestPayload, params *TaskRequestParams) (err error) {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
@@ -156,7 +394,7 @@ func (r *tasksRepository) Update(ctx context.Context, payload *TaskRequestPayloa
 	var args []any
 	var count int64
 
-	stmt, args, _ = pgSquirell.Select("count(*)").From("brands").Where(squirrel.Eq{"brand_id": payload.BrandID, "deleted_at": nil}).ToSql()
+	stmt, args, _ = pgSquirell.Select("count(*)").From("brands").Where(squirrel.Eq{"brand_id": payload.BrandID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
 	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
 	if err != nil {
 		return err
@@ -164,7 +402,7 @@ func (r *tasksRepository) Update(ctx context.Context, payload *TaskRequestPayloa
 		return exceptions.NewInvariantError("brand_id does not exist")
 	}
 
-	stmt, args, _ = pgSquirell.Select("count(*)").From("platforms").Where(squirrel.Eq{"platform_id": payload.PlatformID, "deleted_at": nil}).ToSql()
+	stmt, args, _ = pgSquirell.Select("count(*)").From("platforms").Where(squirrel.Eq{"platform_id": payload.PlatformID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
 	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
 	if err != nil {
 		return err
@@ -172,10 +410,18 @@ func (r *tasksRepository) Update(ctx context.Context, payload *TaskRequestPayloa
 		return exceptions.NewInvariantError("platform_id does not exist")
 	}
 
+	if err = checkMember(ctx, tx, workspaceID, "assignee_id", payload.AssigneeID); err != nil {
+		return err
+	}
+
+	if err = checkMember(ctx, tx, workspaceID, "reviewer_id", payload.ReviewerID); err != nil {
+		return err
+	}
+
 	stmt, args, _ = pgSquirell.Select("count(*)").From("tasks t").
 		LeftJoin("brands b on t.brand_id=b.brand_id").
 		LeftJoin("platforms p on t.platform_id=p.platform_id").
-		Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil}, squirrel.Eq{"b.deleted_at": nil}, squirrel.Eq{"p.deleted_at": nil}, squirrel.Eq{"t.task_id": params.TaskID}}).
+		Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil, "t.workspace_id": workspaceID}, squirrel.Eq{"b.deleted_at": nil}, squirrel.Eq{"p.deleted_at": nil}, squirrel.Eq{"t.task_id": params.TaskID}}).
 		ToSql()
 
 	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
@@ -185,21 +431,174 @@ func (r *tasksRepository) Update(ctx context.Context, payload *TaskRequestPayloa
 		return exceptions.NewInvariantError(err.Error())
 	}
 
-	stmt, args, _ = pgSquirell.Update("tasks").SetMap(map[string]interface{}{
+	if err = r.changeStatus(ctx, tx, workspaceID, params.TaskID, payload.Status, false); err != nil {
+		return err
+	}
+
+	setMap := map[string]interface{}{
 		"title":       payload.Title,
 		"brand_id":    payload.BrandID,
 		"platform_id": payload.PlatformID,
 		"due_date":    payload.DueDate,
 		"payment":     payload.Payment,
-		"status":      payload.Status,
+		"caption":     payload.Caption,
+		"hashtags":    payload.Hashtags,
+		"cta_url":     payload.CTAURL,
 		"updated_at":  squirrel.Expr("NOW()"),
-	}).Where(squirrel.Eq{"task_id": params.TaskID}).ToSql()
+	}
+
+	// like the tags, an assignee or reviewer left out of the payload is kept
+	if payload.AssigneeID != nil {
+		setMap["assignee_id"] = nullID(payload.AssigneeID)
+	}
+
+	if payload.ReviewerID != nil {
+		setMap["reviewer_id"] = nullID(payload.ReviewerID)
+	}
+
+	stmt, args, _ = pgSquirell.Update("tasks").SetMap(setMap).Where(squirrel.Eq{"task_id": params.TaskID, "workspace_id": workspaceID}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if payload.PlatformIDs != nil {
+		if err = setPlatforms(ctx, tx, workspaceID, params.TaskID, payload.PlatformIDs); err != nil {
+			return err
+		}
+	}
+
+	if payload.Tags != nil {
+		if err = setTags(ctx, tx, workspaceID, params.TaskID, payload.Tags); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *tasksRepository) Patch(ctx context.Context, workspaceID int64, payload *TaskPatchPayload, params *TaskRequestParams) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var stmt string
+	var args []any
+	var count int64
+
+	stmt, args, _ = pgSquirell.Select("count(*)").From("tasks t").
+		LeftJoin("brands b on t.brand_id=b.brand_id").
+		LeftJoin("platforms p on t.platform_id=p.platform_id").
+		Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil, "t.workspace_id": workspaceID}, squirrel.Eq{"b.deleted_at": nil}, squirrel.Eq{"p.deleted_at": nil}, squirrel.Eq{"t.task_id": params.TaskID}}).
+		ToSql()
+
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return err
+	} else if count == 0 {
+		return exceptions.NewNotFoundError("tasks not found")
+	}
+
+	setMap := map[string]interface{}{
+		"updated_at": squirrel.Expr("NOW()"),
+	}
+
+	if payload.Title != nil {
+		setMap["title"] = *payload.Title
+	}
+
+	if payload.BrandID != nil {
+		stmt, args, _ = pgSquirell.Select("count(*)").From("brands").Where(squirrel.Eq{"brand_id": *payload.BrandID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
+		err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+		if err != nil {
+			return err
+		} else if count == 0 {
+			return exceptions.NewInvariantError("brand_id does not exist")
+		}
+
+		setMap["brand_id"] = *payload.BrandID
+	}
+
+	if payload.PlatformID != nil {
+		stmt, args, _ = pgSquirell.Select("count(*)").From("platforms").Where(squirrel.Eq{"platform_id": *payload.PlatformID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
+		err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+		if err != nil {
+			return err
+		} else if count == 0 {
+			return exceptions.NewInvariantError("platform_id does not exist")
+		}
+
+		setMap["platform_id"] = *payload.PlatformID
+	}
+
+	if payload.DueDate != nil {
+		setMap["due_date"] = *payload.DueDate
+	}
+
+	if payload.Payment != nil {
+		setMap["payment"] = *payload.Payment
+	}
+
+	if payload.Caption != nil {
+		setMap["caption"] = *payload.Caption
+	}
+
+	if payload.Hashtags != nil {
+		setMap["hashtags"] = *payload.Hashtags
+	}
+
+	if payload.CTAURL != nil {
+		setMap["cta_url"] = *payload.CTAURL
+	}
+
+	if payload.AssigneeID != nil {
+		if err = checkMember(ctx, tx, workspaceID, "assignee_id", payload.AssigneeID); err != nil {
+			return err
+		}
+
+		setMap["assignee_id"] = nullID(payload.AssigneeID)
+	}
+
+	if payload.ReviewerID != nil {
+		if err = checkMember(ctx, tx, workspaceID, "reviewer_id", payload.ReviewerID); err != nil {
+			return err
+		}
+
+		setMap["reviewer_id"] = nullID(payload.ReviewerID)
+	}
+
+	if payload.Status != nil {
+		if err = r.changeStatus(ctx, tx, workspaceID, params.TaskID, *payload.Status, false); err != nil {
+			return err
+		}
+	}
+
+	stmt, args, _ = pgSquirell.Update("tasks").SetMap(setMap).Where(squirrel.Eq{"task_id": params.TaskID, "workspace_id": workspaceID}).ToSql()
 
 	_, err = tx.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
 
+	if payload.PlatformIDs != nil {
+		if err = setPlatforms(ctx, tx, workspaceID, params.TaskID, *payload.PlatformIDs); err != nil {
+			return err
+		}
+	}
+
+	if payload.Tags != nil {
+		if err = setTags(ctx, tx, workspaceID, params.TaskID, *payload.Tags); err != nil {
+			return err
+		}
+	}
+
 	if err = tx.Commit(); err != nil {
 		return err
 	}
@@ -207,7 +606,7 @@ func (r *tasksRepository) Update(ctx context.Context, payload *TaskRequestPayloa
 	return nil
 }
 
-func (r *tasksRepository) Delete(ctx context.Context, params *TaskRequestParams) error {
+func (r *tasksRepository) Delete(ctx context.Context, workspaceID int64, params *TaskRequestParams) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
 
 	if err != nil {
@@ -222,7 +621,7 @@ func (r *tasksRepository) Delete(ctx context.Context, params *TaskRequestParams)
 	stmt, args, _ = pgSquirell.Select("count(*)").From("tasks t").
 					LeftJoin("brands b on t.brand_id=b.brand_id").
 					LeftJoin("platforms p on t.platform_id=p.platform_id").
-					Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil}, squirrel.Eq{"b.deleted_at":nil}, squirrel.Eq{"p.deleted_at":nil}, squirrel.Eq{"t.task_id":params.TaskID}}).
+					Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil, "t.workspace_id": workspaceID}, squirrel.Eq{"b.deleted_at":nil}, squirrel.Eq{"p.deleted_at":nil}, squirrel.Eq{"t.task_id":params.TaskID}}).
 					ToSql()
 
 	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
@@ -234,7 +633,7 @@ func (r *tasksRepository) Delete(ctx context.Context, params *TaskRequestParams)
 
 	stmt, args, _ = pgSquirell.Update("tasks").SetMap(map[string]interface{}{
 		"deleted_at":squirrel.Expr("NOW()"),
-	}).Where(squirrel.Eq{"task_id":params.TaskID}).ToSql()
+	}).Where(squirrel.Eq{"task_id":params.TaskID, "workspace_id":workspaceID}).ToSql()
 
 	_, err = tx.ExecContext(ctx, stmt, args...)
 	if err != nil {
@@ -246,4 +645,663 @@ func (r *tasksRepository) Delete(ctx context.Context, params *TaskRequestParams)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// GetPlatforms returns the platforms of the given tasks, the primary platform
+// of each task first.
+func (r *tasksRepository) GetPlatforms(ctx context.Context, taskIDs []int64) (resp []*TaskPlatforms, err error) {
+	resp = []*TaskPlatforms{}
+	if len(taskIDs) == 0 {
+		return resp, nil
+	}
+
+	stmt, args, _ := pgSquirell.Select("tp.task_id", "tp.platform_id", "p.platform", "tp.platform_id = t.platform_id AS is_primary", "tp.status", "tp.payment").
+						From("task_platforms tp").
+						Join("tasks t on tp.task_id=t.task_id").
+						Join("platforms p on tp.platform_id=p.platform_id").
+						Where(squirrel.And{squirrel.Eq{"tp.task_id": taskIDs}, squirrel.Eq{"p.deleted_at": nil}}).
+						OrderBy("tp.task_id ASC", "is_primary DESC", "p.platform ASC").
+						ToSql()
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// GetTags returns the tags of the given tasks in alphabetical order.
+func (r *tasksRepository) GetTags(ctx context.Context, taskIDs []int64) (resp []*TaskTags, err error) {
+	resp = []*TaskTags{}
+	if len(taskIDs) == 0 {
+		return resp, nil
+	}
+
+	stmt, args, _ := pgSquirell.Select("tt.task_id", "g.tag_id", "g.tag").
+						From("task_tags tt").
+						Join("tags g on tt.tag_id=g.tag_id").
+						Where(squirrel.And{squirrel.Eq{"tt.task_id": taskIDs}, squirrel.Eq{"g.deleted_at": nil}}).
+						OrderBy("tt.task_id ASC", "g.tag ASC").
+						ToSql()
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// GetProgress counts the checklist items of the given tasks, tasks without a
+// checklist are left out.
+func (r *tasksRepository) GetProgress(ctx context.Context, taskIDs []int64) (resp []*TaskProgress, err error) {
+	resp = []*TaskProgress{}
+	if len(taskIDs) == 0 {
+		return resp, nil
+	}
+
+	stmt, args, _ := pgSquirell.Select("task_id", "count(*) AS total", "count(*) FILTER (WHERE completed) AS completed").
+						From("task_checklist_items").
+						Where(squirrel.Eq{"task_id": taskIDs, "deleted_at": nil}).
+						GroupBy("task_id").
+						ToSql()
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// GetCommentCounts counts the comments of the given tasks that are not
+// deleted, tasks without comments are left out.
+func (r *tasksRepository) GetCommentCounts(ctx context.Context, taskIDs []int64) (resp []*TaskCommentCount, err error) {
+	resp = []*TaskCommentCount{}
+	if len(taskIDs) == 0 {
+		return resp, nil
+	}
+
+	stmt, args, _ := pgSquirell.Select("task_id", "count(*) AS comments").
+						From("task_comments").
+						Where(squirrel.Eq{"task_id": taskIDs, "deleted_at": nil}).
+						GroupBy("task_id").
+						ToSql()
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// UpdatePlatform sets the status and payment of a task on one of its
+// platforms. The status of the primary platform is the status of the task, so
+// it is changed through changeStatus, the status on any other platform goes
+// through the same transitions and is recorded with its platform.
+func (r *tasksRepository) UpdatePlatform(ctx context.Context, workspaceID int64, params *TaskPlatformParams, payload *TaskPlatformPayload) (err error) {
+	var primaryID sql.NullInt64
+	var current string
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// the task is locked before its platform, in the order changeStatus and
+	// the trigger it fires take them
+	stmt, args, _ := selectTasks("t.platform_id").
+						Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil, "t.workspace_id": workspaceID}, squirrel.Eq{"b.deleted_at": nil}, squirrel.Eq{"p.deleted_at": nil}, squirrel.Eq{"t.task_id": params.TaskID}}).
+						Suffix("FOR UPDATE OF t").
+						ToSql()
+
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&primaryID)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	} else if err == sql.ErrNoRows {
+		return exceptions.NewNotFoundError("tasks not found")
+	}
+
+	stmt, args, _ = pgSquirell.Select("status").From("task_platforms").Where(squirrel.Eq{"task_id": params.TaskID, "platform_id": params.PlatformID}).Suffix("FOR UPDATE").ToSql()
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&current)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	} else if err == sql.ErrNoRows {
+		return exceptions.NewNotFoundError("task platforms not found")
+	}
+
+	if primaryID.Valid && strconv.FormatInt(primaryID.Int64, 10) == params.PlatformID {
+		if err = r.changeStatus(ctx, tx, workspaceID, params.TaskID, payload.Status, false); err != nil {
+			return err
+		}
+	} else if current != payload.Status {
+		if !canTransition(current, payload.Status, false) {
+			return exceptions.NewInvariantError(fmt.Sprintf("cannot change status from %s to %s", current, payload.Status))
+		}
+
+		stmt, args, _ = pgSquirell.Insert("task_status_history").Columns("task_id", "platform_id", "from_status", "to_status").Values(params.TaskID, params.PlatformID, current, payload.Status).ToSql()
+
+		_, err = tx.ExecContext(ctx, stmt, args...)
+		if err != nil {
+			return err
+		}
+	}
+
+	stmt, args, _ = pgSquirell.Update("task_platforms").SetMap(map[string]interface{}{
+		"status":     payload.Status,
+		"payment":    payload.Payment,
+		"updated_at": squirrel.Expr("NOW()"),
+	}).Where(squirrel.Eq{"task_id": params.TaskID, "platform_id": params.PlatformID}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setPlatforms makes platformIDs the platforms of a task. Platforms the task
+// already has keep their status and payment, new ones start with the status
+// of the task. The primary platform must be among platformIDs.
+func setPlatforms(ctx context.Context, tx *sqlx.Tx, workspaceID int64, taskID any, platformIDs []int64) (err error) {
+	var count int
+
+	stmt, args, _ := pgSquirell.Select("count(*)").From("platforms").Where(squirrel.Eq{"platform_id": platformIDs, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return err
+	} else if count != len(platformIDs) {
+		return exceptions.NewInvariantError("platform_ids contains a platform that does not exist")
+	}
+
+	stmt, args, _ = pgSquirell.Delete("task_platforms").Where(squirrel.And{squirrel.Eq{"task_id": taskID}, squirrel.NotEq{"platform_id": platformIDs}}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	missing := squirrel.Select("t.task_id", "p.platform_id", "t.status").
+					From("tasks t").
+					Join("platforms p on p.platform_id = ANY(?)", platformIDs).
+					Where(squirrel.Eq{"t.task_id": taskID})
+
+	stmt, args, _ = pgSquirell.Insert("task_platforms").Columns("task_id", "platform_id", "status").Select(missing).Suffix("ON CONFLICT (task_id, platform_id) DO NOTHING").ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setTags makes tagIDs the tags of a task.
+func setTags(ctx context.Context, tx *sqlx.Tx, workspaceID int64, taskID any, tagIDs []int64) (err error) {
+	tagIDs = slices.Compact(slices.Sorted(slices.Values(tagIDs)))
+
+	var count int
+
+	stmt, args, _ := pgSquirell.Select("count(*)").From("tags").Where(squirrel.Eq{"tag_id": tagIDs, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return err
+	} else if count != len(tagIDs) {
+		return exceptions.NewInvariantError("tags contains a tag that does not exist")
+	}
+
+	stmt, args, _ = pgSquirell.Delete("task_tags").Where(squirrel.And{squirrel.Eq{"task_id": taskID}, squirrel.NotEq{"tag_id": tagIDs}}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	insert := pgSquirell.Insert("task_tags").Columns("task_id", "tag_id")
+	for _, tagID := range tagIDs {
+		insert = insert.Values(taskID, tagID)
+	}
+
+	if len(tagIDs) > 0 {
+		stmt, args, _ = insert.Suffix("ON CONFLICT (task_id, tag_id) DO NOTHING").ToSql()
+
+		_, err = tx.ExecContext(ctx, stmt, args...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkMember makes sure the user given in field can be put on a task of the
+// workspace. A missing or zero userID leaves the field empty and is always
+// allowed.
+func checkMember(ctx context.Context, tx *sqlx.Tx, workspaceID int64, field string, userID *int64) (err error) {
+	if userID == nil || *userID == 0 {
+		return nil
+	}
+
+	var count int64
+
+	stmt, args, _ := pgSquirell.Select("count(*)").From("workspace_members m").
+						Join("users u on m.user_id=u.user_id").
+						Where(squirrel.Eq{"m.workspace_id": workspaceID, "m.user_id": userID, "u.deleted_at": nil}).
+						ToSql()
+
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return err
+	} else if count == 0 {
+		return exceptions.NewInvariantError(fmt.Sprintf("%s is not a member of the workspace", field))
+	}
+
+	return nil
+}
+
+// nullID stores a missing or zero ID as NULL.
+func nullID(id *int64) any {
+	if id == nil || *id == 0 {
+		return nil
+	}
+
+	return *id
+}
+
+// tasksFilter builds the WHERE clause shared by GetAll and Count so that the
+// listed rows and the total page count always agree.
+func tasksFilter(workspaceID int64, query *TaskFilter) squirrel.And {
+	keyword := query.Keyword
+	utils.KeywordHelper(&keyword)
+
+	filter := squirrel.And{squirrel.Eq{"t.deleted_at": nil, "t.workspace_id": workspaceID}, squirrel.ILike{"t.title": keyword}, squirrel.Eq{"b.deleted_at":nil}, squirrel.Eq{"p.deleted_at":nil}}
+
+	if len(query.Status) > 0 {
+		filter = append(filter, squirrel.Eq{"t.status": query.Status})
+	}
+	if len(query.BrandID) > 0 {
+		filter = append(filter, squirrel.Eq{"t.brand_id": query.BrandID})
+	}
+	if len(query.PlatformID) > 0 {
+		// a task matches on any of its platforms, not only the primary one
+		filter = append(filter, squirrel.Expr("t.task_id IN (?)", squirrel.Select("task_id").From("task_platforms").Where(squirrel.Eq{"platform_id": query.PlatformID})))
+	}
+	if query.DueFrom != "" {
+		filter = append(filter, squirrel.Expr("t.due_date >= ?::date", query.DueFrom))
+	}
+	if query.DueTo != "" {
+		filter = append(filter, squirrel.Expr("t.due_date < ?::date + 1", query.DueTo))
+	}
+	if query.MinPayment != nil {
+		filter = append(filter, squirrel.GtOrEq{"t.payment": *query.MinPayment})
+	}
+	if query.MaxPayment != nil {
+		filter = append(filter, squirrel.LtOrEq{"t.payment": *query.MaxPayment})
+	}
+	if len(query.Hashtag) > 0 {
+		filter = append(filter, squirrel.Expr("t.hashtags && ?", query.Hashtag))
+	}
+	if query.Overdue != nil {
+		filter = append(filter, squirrel.Eq{"t.overdue": *query.Overdue})
+	}
+	if query.Assignee == AssigneeUnassigned {
+		filter = append(filter, squirrel.Eq{"t.assignee_id": nil})
+	} else if query.Assignee != "" {
+		filter = append(filter, squirrel.Eq{"t.assignee_id": query.assigneeID})
+	}
+	if query.IncompleteChecklist != nil {
+		open := "EXISTS (SELECT 1 FROM task_checklist_items c WHERE c.task_id = t.task_id AND c.deleted_at IS NULL AND NOT c.completed)"
+		if !*query.IncompleteChecklist {
+			open = "NOT " + open
+		}
+		filter = append(filter, squirrel.Expr(open))
+	}
+	if len(query.Tag) > 0 {
+		names := []string{}
+		for _, tag := range query.Tag {
+			names = append(names, strings.ToLower(tag))
+		}
+		names = slices.Compact(slices.Sorted(slices.Values(names)))
+
+		tagged := squirrel.Select("tt.task_id").
+						From("task_tags tt").
+						Join("tags g on tt.tag_id=g.tag_id").
+						Where(squirrel.Eq{"g.deleted_at": nil, "lower(g.tag)": names})
+		if query.TagMatch == "all" {
+			// tag names are unique, so a task with every tag matches each name once
+			tagged = tagged.GroupBy("tt.task_id").Having("count(*) = ?", len(names))
+		}
+
+		filter = append(filter, squirrel.Expr("t.task_id IN (?)", tagged))
+	}
+
+	return filter
+}
+
+func (r *tasksRepository) Reopen(ctx context.Context, workspaceID int64, params *TaskRequestParams) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err = r.changeStatus(ctx, tx, workspaceID, params.TaskID, StatusPending, true); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *tasksRepository) GetStatusHistory(ctx context.Context, workspaceID int64, params *TaskRequestParams) (resp []*TaskStatusHistory, err error) {
+	resp = []*TaskStatusHistory{}
+
+	var stmt string
+	var args []any
+	var count int64
+
+	stmt, args, _ = pgSquirell.Select("count(*)").From("tasks").Where(squirrel.Eq{"task_id": params.TaskID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
+	err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return resp, err
+	} else if count == 0 {
+		return nil, exceptions.NewNotFoundError("tasks not found")
+	}
+
+	stmt, args, _ = pgSquirell.Select("history_id", "task_id", "platform_id", "from_status", "to_status", "changed_at").
+						From("task_status_history").
+						Where(squirrel.Eq{"task_id": params.TaskID}).
+						OrderBy("changed_at ASC", "history_id ASC").
+						ToSql()
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// changeStatus moves a task to a new status inside tx, enforcing the
+// transition policy and recording the change in task_status_history. Setting
+// the status a task already has is a no-op. The tasks_primary_platform trigger
+// copies the status to the row of the primary platform.
+func (r *tasksRepository) changeStatus(ctx context.Context, tx *sqlx.Tx, workspaceID int64, taskID any, status string, reopen bool) error {
+	var current string
+
+	stmt, args, _ := pgSquirell.Select("status").From("tasks").Where(squirrel.Eq{"task_id": taskID, "workspace_id": workspaceID, "deleted_at": nil}).Suffix("FOR UPDATE").ToSql()
+	err := tx.QueryRowxContext(ctx, stmt, args...).Scan(&current)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	} else if err == sql.ErrNoRows {
+		return exceptions.NewNotFoundError("tasks not found")
+	}
+
+	if current == status && !reopen {
+		return nil
+	}
+
+	if !canTransition(current, status, reopen) {
+		if reopen {
+			return exceptions.NewInvariantError("only completed tasks can be reopened")
+		}
+		return exceptions.NewInvariantError(fmt.Sprintf("cannot change status from %s to %s", current, status))
+	}
+
+	if status == StatusCompleted {
+		var open int64
+
+		stmt, args, _ = pgSquirell.Select("count(*)").From("task_checklist_items").Where(squirrel.Eq{"task_id": taskID, "required": true, "completed": false, "deleted_at": nil}).ToSql()
+		if err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&open); err != nil {
+			return err
+		} else if open > 0 {
+			return exceptions.NewInvariantError(fmt.Sprintf("cannot complete the task while %d required checklist items are open", open))
+		}
+	}
+
+	setMap := map[string]interface{}{
+		"status":     status,
+		"updated_at": squirrel.Expr("NOW()"),
+	}
+
+	// a completed task is never late, there is no need to wait for the sweeper
+	if status == StatusCompleted {
+		setMap["overdue"] = false
+	}
+
+	stmt, args, _ = pgSquirell.Update("tasks").SetMap(setMap).Where(squirrel.Eq{"task_id": taskID, "workspace_id": workspaceID}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	stmt, args, _ = pgSquirell.Insert("task_status_history").Columns("task_id", "from_status", "to_status").Values(taskID, current, status).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *tasksRepository) GetCalendar(ctx context.Context, workspaceID int64, query *TaskCalendarQuery) (resp []*Tasks, err error) {
+	stmt, args, _ := selectTasks(tasksColumns...).
+						Where(calendarFilter(workspaceID, query)).
+						OrderBy("t.due_date ASC", "t.task_id ASC").
+						ToSql()
+
+	resp = []*Tasks{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// GetCalendarSummary counts the tasks of every non-empty bucket by status and
+// sums their payment. Buckets start on the day, the Monday of the week or the
+// first day of the month depending on the granularity.
+func (r *tasksRepository) GetCalendarSummary(ctx context.Context, workspaceID int64, query *TaskCalendarQuery) (resp []*TaskCalendarSummary, err error) {
+	stmt, args, _ := selectTasks().
+						Column(squirrel.Alias(squirrel.Expr("date_trunc(?, t.due_date)::date", query.Granularity), "bucket")).
+						Column("count(*) AS total").
+						Column("count(*) FILTER (WHERE t.status = ?) AS pending", StatusPending).
+						Column("count(*) FILTER (WHERE t.status = ?) AS completed", StatusCompleted).
+						Column("count(*) FILTER (WHERE t.status = ?) AS scheduled", StatusScheduled).
+						Column("COALESCE(sum(t.payment), 0) AS payment").
+						Where(calendarFilter(workspaceID, query)).
+						GroupBy("bucket").
+						OrderBy("bucket ASC").
+						ToSql()
+
+	resp = []*TaskCalendarSummary{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func calendarFilter(workspaceID int64, query *TaskCalendarQuery) squirrel.And {
+	return tasksFilter(workspaceID, &TaskFilter{
+		Status:     query.Status,
+		BrandID:    query.BrandID,
+		PlatformID: query.PlatformID,
+		DueFrom:    query.From,
+		DueTo:      query.To,
+	})
+}
+
+// ForEach passes every task matching query to fn in the requested sort. Rows
+// are read from the cursor one at a time so large results are never held in
+// memory.
+func (r *tasksRepository) ForEach(ctx context.Context, workspaceID int64, query *TaskExportQuery, fn func(*Tasks) error) (err error) {
+	sortFields, err := utils.ParseSort(query.Sort, tasksSortColumns, "task_id")
+	if err != nil {
+		return err
+	}
+
+	stmt, args, _ := selectTasks(tasksColumns...).
+						Where(tasksFilter(workspaceID, &query.TaskFilter)).
+						OrderBy(utils.OrderByClauses(sortFields)...).
+						ToSql()
+
+	rows, err := r.db.QueryxContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		task := &Tasks{}
+
+		if err = rows.StructScan(task); err != nil {
+			return err
+		}
+
+		if err = fn(task); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Bulk applies the operation to every task in one transaction. Each task runs
+// inside its own savepoint, so a task that cannot be changed is reported and
+// rolled back without undoing the others.
+func (r *tasksRepository) Bulk(ctx context.Context, workspaceID int64, payload *TaskBulkPayload) (resp []*TaskBulkItemResult, err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	if payload.Operation == BulkSetPlatform {
+		var count int64
+
+		stmt, args, _ := pgSquirell.Select("count(*)").From("platforms").Where(squirrel.Eq{"platform_id": payload.PlatformID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
+		err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+		if err != nil {
+			return nil, err
+		} else if count == 0 {
+			return nil, exceptions.NewInvariantError("platform_id does not exist")
+		}
+	}
+
+	resp = []*TaskBulkItemResult{}
+	for _, taskID := range payload.TaskIDs {
+		if _, err = tx.ExecContext(ctx, "SAVEPOINT bulk_task"); err != nil {
+			return nil, err
+		}
+
+		result := &TaskBulkItemResult{TaskID: taskID, Success: true}
+
+		err = r.bulkApply(ctx, tx, workspaceID, taskID, payload)
+		if errors.As(err, &exceptions.InvariantError{}) || errors.As(err, &exceptions.NotFoundError{}) {
+			result.Success = false
+			result.Error = err.Error()
+
+			if _, err = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT bulk_task"); err != nil {
+				return nil, err
+			}
+		} else if err != nil {
+			return nil, err
+		}
+
+		if _, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT bulk_task"); err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, result)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *tasksRepository) bulkApply(ctx context.Context, tx *sqlx.Tx, workspaceID int64, taskID int64, payload *TaskBulkPayload) (err error) {
+	var count int64
+
+	stmt, args, _ := selectTasks("count(*)").
+						Where(squirrel.And{squirrel.Eq{"t.deleted_at": nil, "t.workspace_id": workspaceID}, squirrel.Eq{"b.deleted_at": nil}, squirrel.Eq{"p.deleted_at": nil}, squirrel.Eq{"t.task_id": taskID}}).
+						ToSql()
+
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return err
+	} else if count == 0 {
+		return exceptions.NewNotFoundError("tasks not found")
+	}
+
+	if payload.Operation == BulkSetStatus {
+		return r.changeStatus(ctx, tx, workspaceID, taskID, payload.Status, false)
+	}
+
+	setMap := map[string]interface{}{
+		"updated_at": squirrel.Expr("NOW()"),
+	}
+
+	switch payload.Operation {
+	case BulkShiftDueDate:
+		setMap["due_date"] = squirrel.Expr("due_date + make_interval(days => ?)", payload.Days)
+	case BulkSetPlatform:
+		setMap["platform_id"] = payload.PlatformID
+	case BulkDelete:
+		setMap["deleted_at"] = squirrel.Expr("NOW()")
+	}
+
+	stmt, args, _ = pgSquirell.Update("tasks").SetMap(setMap).Where(squirrel.Eq{"task_id": taskID, "workspace_id": workspaceID}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SweepOverdue flags the open tasks whose due date has passed and clears the
+// flag of tasks that were completed or rescheduled since the last sweep.
+func (r *tasksRepository) SweepOverdue(ctx context.Context) (err error) {
+	late := squirrel.And{squirrel.Eq{"deleted_at": nil}, squirrel.NotEq{"status": StatusCompleted}, squirrel.Expr("due_date < CURRENT_DATE")}
+
+	stmt, args, _ := pgSquirell.Update("tasks").Set("overdue", true).
+						Where(squirrel.And{squirrel.Eq{"overdue": false}, late}).
+						ToSql()
+
+	if _, err = r.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+
+	stmt, args, _ = pgSquirell.Update("tasks").Set("overdue", false).
+						Where(squirrel.And{squirrel.Eq{"overdue": true}, squirrel.Expr("NOT (?)", late)}).
+						ToSql()
+
+	if _, err = r.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -2,36 +2,71 @@ package tasks
 
 import (
 	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/custom_validator"
+	"github.com/agungramananda/sosmed-todolist/internal/common/events"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
 	"github.com/agungramananda/sosmed-todolist/internal/common/httpres"
 	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/go-playground/validator/v10"
+	"github.com/rs/zerolog"
 )
 
 type TasksService interface {
 	GetAll(context.Context, *TaskRequestQuery) (*ListofTasks, error)
+	GetMine(context.Context, *TaskMineQuery) (*ListofTasks, error)
 	GetOne(context.Context, *TaskRequestParams) (*TaskDetails, error)
 	Create(context.Context, *TaskRequestPayload) error
 	Update(context.Context, *TaskRequestParams, *TaskRequestPayload) error
+	Patch(context.Context, *TaskRequestParams, *TaskPatchPayload) error
 	Delete(context.Context, *TaskRequestParams) error
+	Reopen(context.Context, *TaskRequestParams) error
+	UpdatePlatform(context.Context, *TaskPlatformParams, *TaskPlatformPayload) error
+	GetStatusHistory(context.Context, *TaskRequestParams) (*ListofTaskStatusHistory, error)
+	GetCalendar(context.Context, *TaskCalendarQuery) (*TaskCalendar, error)
+	WriteFeed(context.Context, *TaskFeedQuery, io.Writer) error
+	WriteExport(context.Context, *TaskExportQuery, io.Writer) error
+	Import(context.Context, *TaskImportQuery, *TaskImportPayload) (*TaskImportResult, error)
+	Bulk(context.Context, *TaskBulkPayload) (*TaskBulkResult, error)
+	SweepOverdue(context.Context) error
 }
 
 type tasksService struct {
 	repo      TasksRepository
+	validator *custom_validator.Validator
+	publisher events.Publisher
+	logger    *zerolog.Logger
 }
 
-func NewService(r TasksRepository) *tasksService {
-	return &tasksService{repo: r}
+func NewService(r TasksRepository, v *custom_validator.Validator, p events.Publisher, logger *zerolog.Logger) *tasksService {
+	return &tasksService{repo: r, validator: v, publisher: p, logger: logger}
 }
 
 func (svc tasksService) GetAll(ctx context.Context, query *TaskRequestQuery) (listOfTasks *ListofTasks, err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return &ListofTasks{}, err
+	}
+
 	limit := int(query.Limit)
 	page := int(query.Page)
 	utils.SetDefaultPagination(&limit, &page)
 
-	repoQuery := &TaskRequestQuery{
-		Keyword: query.Keyword,
-		Limit: uint64(limit),
-		Page: uint64(page),
+	repoQuery := *query
+	repoQuery.Limit = uint64(limit)
+	repoQuery.Page = uint64(page)
+	if err = prepareFilter(caller, &repoQuery.TaskFilter); err != nil {
+		return &ListofTasks{}, err
 	}
 
 
@@ -44,26 +79,40 @@ func (svc tasksService) GetAll(ctx context.Context, query *TaskRequestQuery) (li
 		},
 	}
 
-	tasks, err := svc.repo.GetAll(ctx, repoQuery)
+	sortFields, err := utils.ParseSort(repoQuery.Sort, tasksSortColumns, "task_id")
+	if err != nil {
+		return &ListofTasks{}, err
+	}
+
+	var cursor *utils.Cursor
+	if repoQuery.Cursor != "" {
+		if cursor, err = utils.DecodeCursor(repoQuery.Cursor, sortFields); err != nil {
+			return &ListofTasks{}, err
+		}
+		listOfTasks.Meta.Page = 0
+	}
+
+	tasks, err := svc.repo.GetAll(ctx, caller.WorkspaceID, &repoQuery)
 	if err != nil {
 		return &ListofTasks{}, err
 	}
 
+	tasks = utils.PaginateKeyset(tasks, &listOfTasks.Meta, sortFields, cursor, tasksSortValue)
+
 	for _, task := range tasks {
-		listOfTasks.Tasks = append(listOfTasks.Tasks, &TaskDetails{
-			TaskID:     task.TaskID,
-			Title:      task.Title,
-			BrandID:    task.BrandID,
-			Brand:      task.Brand,
-			PlatformID: task.PlatformID,
-			Platform:   task.Platform,
-			DueDate:    task.DueDate.Format("2006-01-02"),
-			Payment:    task.Payment,
-			Status:     task.Status,
-		})
+		listOfTasks.Tasks = append(listOfTasks.Tasks, toTaskDetails(task))
 	}
 
-	total_items, err := svc.repo.Count(ctx, repoQuery)
+	if err = svc.attachDetails(ctx, listOfTasks.Tasks); err != nil {
+		return &ListofTasks{}, err
+	}
+
+	// keyset pages skip the COUNT query, which is what makes them cheap
+	if cursor != nil {
+		return listOfTasks, nil
+	}
+
+	total_items, err := svc.repo.Count(ctx, caller.WorkspaceID, &repoQuery)
 	if err != nil {
 		return &ListofTasks{}, err
 	}
@@ -73,50 +122,884 @@ func (svc tasksService) GetAll(ctx context.Context, query *TaskRequestQuery) (li
 	return listOfTasks, nil
 }
 
+// GetMine lists the tasks assigned to the caller that are not completed yet,
+// the ones due first at the top.
+func (svc tasksService) GetMine(ctx context.Context, query *TaskMineQuery) (listOfTasks *ListofTasks, err error) {
+	return svc.GetAll(ctx, &TaskRequestQuery{
+		TaskFilter: TaskFilter{
+			Status:   []string{StatusPending, StatusScheduled},
+			Assignee: AssigneeMe,
+		},
+		Sort:  "due_date",
+		Limit: query.Limit,
+		Page:  query.Page,
+	})
+}
+
 func (svc *tasksService) GetOne(ctx context.Context, params *TaskRequestParams) (taskDetails *TaskDetails, err error){
-	task, err := svc.repo.GetByID(ctx, params)
+	caller, err := auth.Member(ctx)
 	if err != nil {
 		return taskDetails, err
 	}
 
-	taskDetails = &TaskDetails{
-		TaskID:     task.TaskID,
-		Title:      task.Title,
-		BrandID:    task.BrandID,
-		Brand:      task.Brand,
-		PlatformID: task.PlatformID,
-		Platform:   task.Platform,
-		DueDate:    task.DueDate.Format("2006-01-02"),
-		Payment:    task.Payment,
-		Status:     task.Status,
+	task, err := svc.repo.GetByID(ctx, caller.WorkspaceID, params)
+	if err != nil {
+		return taskDetails, err
+	}
+
+	taskDetails = toTaskDetails(task)
+	if err = svc.attachDetails(ctx, []*TaskDetails{taskDetails}); err != nil {
+		return nil, err
 	}
 
 	return taskDetails, nil
 }
 
 func (svc *tasksService) Create(ctx context.Context, payload *TaskRequestPayload) (err error) {
-	err = svc.repo.Add(ctx, payload)
+	caller, err := auth.Authorize(ctx, auth.PermCreateTasks)
 	if err != nil {
 		return err
 	}
 
+	payload.CreatedBy = sql.NullInt64{Int64: caller.ID, Valid: true}
+
+	if payload.Hashtags, err = utils.NormalizeHashtags(payload.Hashtags); err != nil {
+		return err
+	}
+
+	payload.PlatformID, payload.PlatformIDs = platformSet(payload.PlatformID, payload.PlatformIDs)
+
+	taskID, err := svc.repo.Add(ctx, caller.WorkspaceID, payload)
+	if err != nil {
+		return err
+	}
+
+	svc.publishTasks(ctx, caller.WorkspaceID, events.TaskCreated, []int64{taskID})
+
 	return nil
 }
 
 func (svc *tasksService) Update(ctx context.Context, params *TaskRequestParams, payload *TaskRequestPayload) (err error){
-	err = svc.repo.Update(ctx,payload,params)
+	if payload.Hashtags, err = utils.NormalizeHashtags(payload.Hashtags); err != nil {
+		return err
+	}
+
+	payload.PlatformID, payload.PlatformIDs = platformSet(payload.PlatformID, payload.PlatformIDs)
+
+	caller, before, err := svc.editableTask(ctx, params)
 	if err != nil {
 		return err
 	}
 
+	err = svc.repo.Update(ctx,caller.WorkspaceID,payload,params)
+	if err != nil {
+		return err
+	}
+
+	svc.publishChanges(ctx, caller.WorkspaceID, []*Tasks{before})
+
+	return nil
+}
+
+func (svc *tasksService) Patch(ctx context.Context, params *TaskRequestParams, payload *TaskPatchPayload) (err error){
+	if payload.Hashtags != nil {
+		hashtags, err := utils.NormalizeHashtags(*payload.Hashtags)
+		if err != nil {
+			return err
+		}
+		payload.Hashtags = &hashtags
+	}
+
+	caller, before, err := svc.editableTask(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	if payload.PlatformIDs != nil {
+		// the primary platform stays unless it is dropped from the platforms
+		primary := before.PlatformID
+		if payload.PlatformID != nil {
+			primary = *payload.PlatformID
+		} else if !slices.Contains(*payload.PlatformIDs, primary) {
+			primary = 0
+		}
+
+		primary, platformIDs := platformSet(primary, *payload.PlatformIDs)
+		payload.PlatformID = &primary
+		payload.PlatformIDs = &platformIDs
+	}
+
+	err = svc.repo.Patch(ctx,caller.WorkspaceID,payload,params)
+	if err != nil {
+		return err
+	}
+
+	svc.publishChanges(ctx, caller.WorkspaceID, []*Tasks{before})
+
 	return nil
 }
 
 func (svc *tasksService) Delete(ctx context.Context, params *TaskRequestParams) (err error){
-	err = svc.repo.Delete(ctx, params)
+	caller, err := auth.Authorize(ctx, auth.PermDeleteTasks)
+	if err != nil {
+		return err
+	}
+
+	task, err := svc.repo.GetByID(ctx, caller.WorkspaceID, params)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Delete(ctx, caller.WorkspaceID, params)
+	if err != nil {
+		return err
+	}
+
+	svc.publish(ctx, []*Tasks{task}, func(*Tasks) string { return events.TaskDeleted })
+
+	return nil
+}
+
+func (svc *tasksService) Reopen(ctx context.Context, params *TaskRequestParams) (err error){
+	caller, before, err := svc.editableTask(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Reopen(ctx, caller.WorkspaceID, params)
+	if err != nil {
+		return err
+	}
+
+	svc.publishChanges(ctx, caller.WorkspaceID, []*Tasks{before})
+
+	return nil
+}
+
+func (svc *tasksService) UpdatePlatform(ctx context.Context, params *TaskPlatformParams, payload *TaskPlatformPayload) (err error){
+	if _, err = strconv.ParseInt(params.TaskID, 10, 64); err != nil {
+		return exceptions.NewInvariantError("task_id must be a number")
+	}
+
+	if _, err = strconv.ParseInt(params.PlatformID, 10, 64); err != nil {
+		return exceptions.NewInvariantError("platform_id must be a number")
+	}
+
+	caller, task, err := svc.editableTask(ctx, &TaskRequestParams{TaskID: params.TaskID})
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.UpdatePlatform(ctx, caller.WorkspaceID, params, payload)
 	if err != nil {
 		return err
 	}
 
+	svc.publishChanges(ctx, caller.WorkspaceID, []*Tasks{task})
+
 	return nil
 }
+
+func (svc *tasksService) GetStatusHistory(ctx context.Context, params *TaskRequestParams) (listOfHistory *ListofTaskStatusHistory, err error){
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return listOfHistory, err
+	}
+
+	history, err := svc.repo.GetStatusHistory(ctx, caller.WorkspaceID, params)
+	if err != nil {
+		return listOfHistory, err
+	}
+
+	listOfHistory = &ListofTaskStatusHistory{
+		History: []*TaskStatusHistoryDetails{},
+	}
+
+	for _, entry := range history {
+		details := &TaskStatusHistoryDetails{
+			HistoryID: entry.HistoryID,
+			ToStatus:  entry.ToStatus,
+			ChangedAt: entry.ChangedAt.Format(time.RFC3339),
+		}
+		if entry.PlatformID.Valid {
+			details.PlatformID = &entry.PlatformID.Int64
+		}
+		if entry.FromStatus.Valid {
+			details.FromStatus = &entry.FromStatus.String
+		}
+
+		listOfHistory.History = append(listOfHistory.History, details)
+	}
+
+	return listOfHistory, nil
+}
+
+// GetCalendar groups the tasks due in [from, to] into contiguous day, week or
+// month buckets. Buckets at the edges are clipped to the requested range.
+func (svc *tasksService) GetCalendar(ctx context.Context, query *TaskCalendarQuery) (calendar *TaskCalendar, err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return &TaskCalendar{}, err
+	}
+
+	from, _ := time.Parse("2006-01-02", query.From)
+	to, _ := time.Parse("2006-01-02", query.To)
+
+	if from.After(to) {
+		return &TaskCalendar{}, exceptions.NewInvariantError("from must not be after to")
+	}
+	if to.Sub(from) >= maxCalendarDays*24*time.Hour {
+		return &TaskCalendar{}, exceptions.NewInvariantError(fmt.Sprintf("the calendar cannot span more than %d days", maxCalendarDays))
+	}
+
+	repoQuery := *query
+	if repoQuery.Granularity == "" {
+		repoQuery.Granularity = GranularityDay
+	}
+
+	summaries, err := svc.repo.GetCalendarSummary(ctx, caller.WorkspaceID, &repoQuery)
+	if err != nil {
+		return &TaskCalendar{}, err
+	}
+
+	tasks, err := svc.repo.GetCalendar(ctx, caller.WorkspaceID, &repoQuery)
+	if err != nil {
+		return &TaskCalendar{}, err
+	}
+
+	calendar = &TaskCalendar{
+		From:        query.From,
+		To:          query.To,
+		Granularity: repoQuery.Granularity,
+		Buckets:     []*TaskCalendarBucket{},
+	}
+
+	buckets := map[string]*TaskCalendarBucket{}
+	for start := bucketStart(from, repoQuery.Granularity); !start.After(to); start = nextBucketStart(start, repoQuery.Granularity) {
+		first, last := start, nextBucketStart(start, repoQuery.Granularity).AddDate(0, 0, -1)
+		if first.Before(from) {
+			first = from
+		}
+		if last.After(to) {
+			last = to
+		}
+
+		bucket := &TaskCalendarBucket{
+			Start:        first.Format("2006-01-02"),
+			End:          last.Format("2006-01-02"),
+			TotalPayment: "0.00",
+			Tasks:        []*TaskDetails{},
+		}
+
+		buckets[start.Format("2006-01-02")] = bucket
+		calendar.Buckets = append(calendar.Buckets, bucket)
+	}
+
+	for _, summary := range summaries {
+		bucket, ok := buckets[summary.Bucket.Format("2006-01-02")]
+		if !ok {
+			continue
+		}
+
+		bucket.Total = summary.Total
+		bucket.StatusCounts = TaskStatusCounts{
+			Pending:   summary.Pending,
+			Completed: summary.Completed,
+			Scheduled: summary.Scheduled,
+		}
+		bucket.TotalPayment = summary.Payment
+	}
+
+	listed := []*TaskDetails{}
+	for _, task := range tasks {
+		bucket, ok := buckets[bucketStart(task.DueDate, repoQuery.Granularity).Format("2006-01-02")]
+		if !ok {
+			continue
+		}
+
+		taskDetails := toTaskDetails(task)
+		bucket.Tasks = append(bucket.Tasks, taskDetails)
+		listed = append(listed, taskDetails)
+	}
+
+	if err = svc.attachDetails(ctx, listed); err != nil {
+		return &TaskCalendar{}, err
+	}
+
+	return calendar, nil
+}
+
+// WriteFeed writes the tasks as an iCalendar feed. Nothing is written until
+// the tasks query succeeded, so an early failure can still be reported as an
+// error response.
+func (svc *tasksService) WriteFeed(ctx context.Context, query *TaskFeedQuery, w io.Writer) (err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return err
+	}
+
+	iw := utils.NewICalWriter(w)
+	started := false
+
+	err = svc.repo.ForEach(ctx, caller.WorkspaceID, &TaskExportQuery{TaskFilter: TaskFilter{BrandID: query.BrandID, PlatformID: query.PlatformID}, Sort: "due_date"}, func(task *Tasks) error {
+		if !started {
+			writeFeedHeader(iw)
+			started = true
+		}
+
+		writeTaskEvent(iw, task)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !started {
+		writeFeedHeader(iw)
+	}
+	writeFeedFooter(iw)
+
+	return iw.Flush()
+}
+
+// WriteExport writes every task matching query as CSV, one row at a time.
+func (svc *tasksService) WriteExport(ctx context.Context, query *TaskExportQuery, w io.Writer) (err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return err
+	}
+
+	repoQuery := *query
+	if err = prepareFilter(caller, &repoQuery.TaskFilter); err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	started := false
+
+	err = svc.repo.ForEach(ctx, caller.WorkspaceID, &repoQuery, func(task *Tasks) error {
+		if !started {
+			if err := cw.Write(exportHeader); err != nil {
+				return err
+			}
+			started = true
+		}
+
+		if err := cw.Write(exportRecord(task)); err != nil {
+			return err
+		}
+
+		// hand rows to the client as they come instead of at the end
+		if cw.Flush(); cw.Error() != nil {
+			return cw.Error()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !started {
+		if err = cw.Write(exportHeader); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// Import creates tasks from the rows of a CSV file. Every row is checked with
+// the rules of TaskRequestPayload, the valid rows are then created in a single
+// transaction unless it is a dry run. Invalid rows are reported and skipped.
+func (svc *tasksService) Import(ctx context.Context, query *TaskImportQuery, payload *TaskImportPayload) (result *TaskImportResult, err error) {
+	caller, err := auth.Authorize(ctx, auth.PermCreateTasks)
+	if err != nil {
+		return result, err
+	}
+
+	if payload.File.Size > maxImportSizeMB<<20 {
+		return result, exceptions.NewInvariantError(fmt.Sprintf("file must be at most %d MB", maxImportSizeMB))
+	}
+
+	file, err := payload.File.Open()
+	if err != nil {
+		return result, err
+	}
+	defer file.Close()
+
+	rows, err := readImportRows(file)
+	if err != nil {
+		return result, err
+	}
+
+	brandRefs, platformRefs := []string{}, []string{}
+	for _, row := range rows {
+		brandRefs = append(brandRefs, row.Values["brand"])
+		platformRefs = append(platformRefs, row.Values["platform"])
+	}
+
+	brands, err := svc.repo.FindBrands(ctx, caller.WorkspaceID, brandRefs)
+	if err != nil {
+		return result, err
+	}
+
+	platforms, err := svc.repo.FindPlatforms(ctx, caller.WorkspaceID, platformRefs)
+	if err != nil {
+		return result, err
+	}
+
+	result = &TaskImportResult{
+		DryRun:    query.DryRun,
+		TotalRows: len(rows),
+		TaskIDs:   []int64{},
+		Errors:    []*TaskImportRowError{},
+	}
+
+	payloads := []*TaskRequestPayload{}
+	for _, row := range rows {
+		rowPayload, rowErrors := svc.importPayload(row, brands, platforms)
+		if len(rowErrors) > 0 {
+			result.Errors = append(result.Errors, &TaskImportRowError{Row: row.Line, Errors: rowErrors})
+			continue
+		}
+
+		rowPayload.CreatedBy = sql.NullInt64{Int64: caller.ID, Valid: true}
+		payloads = append(payloads, rowPayload)
+	}
+
+	result.ValidRows = len(payloads)
+	result.InvalidRows = len(result.Errors)
+
+	if query.DryRun || len(payloads) == 0 {
+		return result, nil
+	}
+
+	if result.TaskIDs, err = svc.repo.AddMany(ctx, caller.WorkspaceID, payloads); err != nil {
+		return nil, err
+	}
+
+	svc.publishTasks(ctx, caller.WorkspaceID, events.TaskCreated, result.TaskIDs)
+
+	return result, nil
+}
+
+// importPayload turns a row into a task payload, collecting every problem
+// with the row instead of stopping at the first one.
+func (svc *tasksService) importPayload(row *importRow, brands map[string][]int64, platforms map[string][]int64) (*TaskRequestPayload, []string) {
+	if row.Err != nil {
+		return nil, []string{row.Err.Error()}
+	}
+
+	messages := []string{}
+	payload := &TaskRequestPayload{
+		Title:    row.Values["title"],
+		DueDate:  row.Values["due_date"],
+		Status:   row.Values["status"],
+		Caption:  row.Values["caption"],
+		Hashtags: splitHashtags(row.Values["hashtags"]),
+		CTAURL:   row.Values["cta_url"],
+	}
+
+	resolve := func(column string, refs map[string][]int64) int64 {
+		ref := row.Values[column]
+		if ref == "" {
+			messages = append(messages, fmt.Sprintf("%s is required", column))
+			return 0
+		}
+
+		switch ids := refs[strings.ToLower(ref)]; len(ids) {
+		case 0:
+			messages = append(messages, fmt.Sprintf("%s %s does not exist", column, ref))
+		case 1:
+			return ids[0]
+		default:
+			messages = append(messages, fmt.Sprintf("%s name %s is used more than once, use its ID instead", column, ref))
+		}
+
+		return 0
+	}
+
+	payload.BrandID = resolve("brand", brands)
+	payload.PlatformID = resolve("platform", platforms)
+
+	paymentInvalid := false
+	if value := row.Values["payment"]; value != "" {
+		payment, err := parsePayment(value)
+		if err != nil {
+			messages = append(messages, "payment must be a whole number")
+			paymentInvalid = true
+		}
+		payload.Payment = payment
+	}
+
+	if err := svc.validator.Validate(payload); err != nil {
+		var fieldErrs validator.ValidationErrors
+		if !errors.As(err, &fieldErrs) {
+			return nil, append(messages, err.Error())
+		}
+
+		for _, fieldErr := range fieldErrs {
+			if paymentInvalid && fieldErr.Field() == "Payment" {
+				continue
+			}
+			messages = append(messages, exceptions.ValidationMessage(fieldErr))
+		}
+	}
+
+	hashtags, err := utils.NormalizeHashtags(payload.Hashtags)
+	if err != nil {
+		messages = append(messages, err.Error())
+	}
+	payload.Hashtags = hashtags
+
+	if len(messages) > 0 {
+		return nil, messages
+	}
+
+	return payload, nil
+}
+
+func (svc *tasksService) Bulk(ctx context.Context, payload *TaskBulkPayload) (result *TaskBulkResult, err error) {
+	perm := auth.PermEditOwnTasks
+	if payload.Operation == BulkDelete {
+		perm = auth.PermDeleteTasks
+	}
+
+	caller, err := auth.Authorize(ctx, perm)
+	if err != nil {
+		return result, err
+	}
+
+	// a task listed twice would otherwise be shifted twice
+	seen := map[int64]bool{}
+	taskIDs := []int64{}
+	for _, taskID := range payload.TaskIDs {
+		if !seen[taskID] {
+			seen[taskID] = true
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+
+	before, err := svc.repo.GetByIDs(ctx, caller.WorkspaceID, taskIDs)
+	if err != nil {
+		return result, err
+	}
+
+	// the tasks a creator may not change fail on their own, like tasks that
+	// do not exist, without stopping the rest
+	denied := map[int64]bool{}
+	for _, task := range before {
+		if !canEdit(caller, task) {
+			denied[task.TaskID] = true
+		}
+	}
+
+	repoPayload := *payload
+	repoPayload.TaskIDs = []int64{}
+	for _, taskID := range taskIDs {
+		if !denied[taskID] {
+			repoPayload.TaskIDs = append(repoPayload.TaskIDs, taskID)
+		}
+	}
+
+	repoResults, err := svc.repo.Bulk(ctx, caller.WorkspaceID, &repoPayload)
+	if err != nil {
+		return result, err
+	}
+
+	resultOf := map[int64]*TaskBulkItemResult{}
+	for _, item := range repoResults {
+		resultOf[item.TaskID] = item
+	}
+
+	results := []*TaskBulkItemResult{}
+	for _, taskID := range taskIDs {
+		if denied[taskID] {
+			results = append(results, &TaskBulkItemResult{TaskID: taskID, Error: auth.NotTaskOwnerMessage})
+		} else {
+			results = append(results, resultOf[taskID])
+		}
+	}
+
+	result = &TaskBulkResult{
+		Operation: payload.Operation,
+		Results:   results,
+	}
+
+	changed := map[int64]bool{}
+	for _, item := range results {
+		if item.Success {
+			result.Succeeded++
+			changed[item.TaskID] = true
+		} else {
+			result.Failed++
+		}
+	}
+
+	changedBefore := []*Tasks{}
+	for _, task := range before {
+		if changed[task.TaskID] {
+			changedBefore = append(changedBefore, task)
+		}
+	}
+
+	if payload.Operation == BulkDelete {
+		svc.publish(ctx, changedBefore, func(*Tasks) string { return events.TaskDeleted })
+	} else {
+		svc.publishChanges(ctx, caller.WorkspaceID, changedBefore)
+	}
+
+	return result, nil
+}
+
+// editableTask finds the task the caller is about to change and checks that
+// they may. A creator may only change the tasks they created or are assigned
+// to.
+func (svc *tasksService) editableTask(ctx context.Context, params *TaskRequestParams) (caller *auth.User, task *Tasks, err error) {
+	caller, err = auth.Authorize(ctx, auth.PermEditOwnTasks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task, err = svc.repo.GetByID(ctx, caller.WorkspaceID, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !canEdit(caller, task) {
+		return nil, nil, exceptions.NewForbiddenError(auth.NotTaskOwnerMessage)
+	}
+
+	return caller, task, nil
+}
+
+func canEdit(caller *auth.User, task *Tasks) bool {
+	return caller.CanEditTask(task.CreatedBy.Int64, task.AssigneeID.Int64)
+}
+
+// SweepOverdue refreshes the overdue flag of every task, it is run
+// periodically in the background.
+func (svc *tasksService) SweepOverdue(ctx context.Context) (err error) {
+	return svc.repo.SweepOverdue(ctx)
+}
+
+// publishTasks sends event for each of the tasks as they are now.
+func (svc *tasksService) publishTasks(ctx context.Context, workspaceID int64, event string, taskIDs []int64) {
+	tasks, err := svc.repo.GetByIDs(context.WithoutCancel(ctx), workspaceID, taskIDs)
+	if err != nil {
+		svc.logPublishError(err, taskIDs)
+		return
+	}
+
+	svc.publish(ctx, tasks, func(*Tasks) string { return event })
+}
+
+// publishChanges sends task.completed for the tasks that have just been
+// completed and task.updated for every other changed task, given the tasks as
+// they were before the change.
+func (svc *tasksService) publishChanges(ctx context.Context, workspaceID int64, before []*Tasks) {
+	taskIDs := []int64{}
+	wasCompleted := map[int64]bool{}
+	for _, task := range before {
+		taskIDs = append(taskIDs, task.TaskID)
+		wasCompleted[task.TaskID] = task.Status == StatusCompleted
+	}
+
+	tasks, err := svc.repo.GetByIDs(context.WithoutCancel(ctx), workspaceID, taskIDs)
+	if err != nil {
+		svc.logPublishError(err, taskIDs)
+		return
+	}
+
+	svc.publish(ctx, tasks, func(task *Tasks) string {
+		if task.Status == StatusCompleted && !wasCompleted[task.TaskID] {
+			return events.TaskCompleted
+		}
+		return events.TaskUpdated
+	})
+}
+
+// publish sends the event picked by eventOf for each task. It runs once the
+// change is saved, so a failure is logged rather than failing the request
+// that made the change.
+func (svc *tasksService) publish(ctx context.Context, tasks []*Tasks, eventOf func(*Tasks) string) {
+	details := []*TaskDetails{}
+	taskIDs := []int64{}
+	for _, task := range tasks {
+		details = append(details, toTaskDetails(task))
+		taskIDs = append(taskIDs, task.TaskID)
+	}
+
+	if err := svc.attachDetails(context.WithoutCancel(ctx), details); err != nil {
+		svc.logPublishError(err, taskIDs)
+		return
+	}
+
+	for i, task := range tasks {
+		svc.publisher.Publish(ctx, eventOf(task), details[i])
+	}
+}
+
+func (svc *tasksService) logPublishError(err error, taskIDs []int64) {
+	svc.logger.Error().Err(err).Ints64("task_ids", taskIDs).Msg("failed to publish task events")
+}
+
+// attachDetails fills in the platforms, the tags, the checklist progress and
+// the comment count of the tasks, with one query each.
+func (svc *tasksService) attachDetails(ctx context.Context, details []*TaskDetails) (err error) {
+	taskIDs := []int64{}
+	byID := map[int64]*TaskDetails{}
+	for _, taskDetails := range details {
+		taskDetails.Platforms = []*TaskPlatformDetails{}
+		taskDetails.Tags = []*TaskTagDetails{}
+		taskIDs = append(taskIDs, taskDetails.TaskID)
+		byID[taskDetails.TaskID] = taskDetails
+	}
+
+	platforms, err := svc.repo.GetPlatforms(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, platform := range platforms {
+		taskDetails, ok := byID[platform.TaskID]
+		if !ok {
+			continue
+		}
+
+		platformDetails := &TaskPlatformDetails{
+			PlatformID: platform.PlatformID,
+			Platform:   platform.Platform,
+			Primary:    platform.Primary,
+			Status:     platform.Status,
+		}
+		if platform.Payment.Valid {
+			platformDetails.Payment = &platform.Payment.String
+		}
+
+		taskDetails.Platforms = append(taskDetails.Platforms, platformDetails)
+	}
+
+	tags, err := svc.repo.GetTags(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if taskDetails, ok := byID[tag.TaskID]; ok {
+			taskDetails.Tags = append(taskDetails.Tags, &TaskTagDetails{
+				TagID: tag.TagID,
+				Tag:   tag.Tag,
+			})
+		}
+	}
+
+	progress, err := svc.repo.GetProgress(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, count := range progress {
+		taskDetails, ok := byID[count.TaskID]
+		if !ok || count.Total == 0 {
+			continue
+		}
+
+		percent := count.Completed * 100 / count.Total
+		taskDetails.Progress = &percent
+	}
+
+	comments, err := svc.repo.GetCommentCounts(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, count := range comments {
+		if taskDetails, ok := byID[count.TaskID]; ok {
+			taskDetails.CommentCount = count.Comments
+		}
+	}
+
+	return nil
+}
+
+// platformSet puts the primary platform first among the platforms of a task
+// and drops duplicates. Without a primary platform the first one is used, and
+// without platforms the task only has its primary platform.
+func platformSet(primary int64, platformIDs []int64) (int64, []int64) {
+	if len(platformIDs) == 0 {
+		return primary, nil
+	}
+
+	if primary == 0 {
+		primary = platformIDs[0]
+	}
+
+	set := []int64{primary}
+	for _, platformID := range platformIDs {
+		if !slices.Contains(set, platformID) {
+			set = append(set, platformID)
+		}
+	}
+
+	return primary, set
+}
+
+// prepareFilter checks the ranges of a filter, normalises its hashtags and
+// resolves its assignee for the caller.
+func prepareFilter(caller *auth.User, filter *TaskFilter) (err error) {
+	if filter.DueFrom != "" && filter.DueTo != "" && filter.DueFrom > filter.DueTo {
+		return exceptions.NewInvariantError("due_from must not be after due_to")
+	}
+	if filter.MinPayment != nil && filter.MaxPayment != nil && *filter.MinPayment > *filter.MaxPayment {
+		return exceptions.NewInvariantError("min_payment must not be greater than max_payment")
+	}
+
+	if filter.Hashtag, err = utils.NormalizeHashtags(filter.Hashtag); err != nil {
+		return err
+	}
+
+	switch filter.Assignee {
+	case "", AssigneeUnassigned:
+	case AssigneeMe:
+		filter.assigneeID = caller.ID
+	default:
+		filter.assigneeID, err = strconv.ParseInt(filter.Assignee, 10, 64)
+		if err != nil || filter.assigneeID < 1 {
+			return exceptions.NewInvariantError("assignee must be me, unassigned or a user ID")
+		}
+	}
+
+	return nil
+}
+
+func toTaskDetails(task *Tasks) *TaskDetails {
+	details := &TaskDetails{
+		TaskID:     task.TaskID,
+		Title:      task.Title,
+		BrandID:    task.BrandID,
+		Brand:      task.Brand,
+		PlatformID: task.PlatformID,
+		Platform:   task.Platform,
+		DueDate:    task.DueDate.Format("2006-01-02"),
+		Payment:    task.Payment,
+		Status:     task.Status,
+		Caption:    task.Caption,
+		Hashtags:   task.Hashtags,
+		CTAURL:     task.CTAURL,
+		Overdue:    task.Overdue,
+	}
+	if task.CreatedBy.Valid {
+		details.CreatedBy = &task.CreatedBy.Int64
+	}
+	if task.AssigneeID.Valid {
+		details.AssigneeID = &task.AssigneeID.Int64
+	}
+	if task.ReviewerID.Valid {
+		details.ReviewerID = &task.ReviewerID.Int64
+	}
+
+	return details
+}
@@ -0,0 +1,29 @@
+package tasks
+
+const (
+	StatusPending   = "Pending"
+	StatusCompleted = "Completed"
+	StatusScheduled = "Scheduled"
+)
+
+// statusTransitions lists the statuses a task may move to from each status.
+// A completed task is final unless it is explicitly reopened.
+var statusTransitions = map[string][]string{
+	StatusPending:   {StatusScheduled, StatusCompleted},
+	StatusScheduled: {StatusPending, StatusCompleted},
+	StatusCompleted: {},
+}
+
+func canTransition(from string, to string, reopen bool) bool {
+	if reopen {
+		return from == StatusCompleted && to == StatusPending
+	}
+
+	for _, allowed := range statusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
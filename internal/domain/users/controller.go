@@ -0,0 +1,32 @@
+package users
+
+import "github.com/labstack/echo/v4"
+
+type UsersController struct {
+	svc UsersService
+}
+
+func NewController(svc UsersService) *UsersController {
+	return &UsersController{
+		svc: svc,
+	}
+}
+
+const (
+	// AuthBasepath holds the routes that work without an access token.
+	AuthBasepath  = "/auth"
+	usersBasepath = "/users"
+)
+
+func (con *UsersController) Route(grp *echo.Group){
+	authrouter := grp.Group(AuthBasepath)
+
+	authrouter.POST("/register", HandleRegister(con.svc.Register))
+	authrouter.POST("/login", HandleLogin(con.svc.Login))
+	authrouter.POST("/refresh", HandleRefresh(con.svc.Refresh))
+	authrouter.POST("/logout", HandleLogout(con.svc.Logout))
+
+	subrouter := grp.Group(usersBasepath)
+
+	subrouter.GET("/me", HandleMe(con.svc.Me))
+}
@@ -0,0 +1,34 @@
+package users
+
+type RegisterPayload struct {
+	Email string `json:"email" validate:"required,email,max=254" example:"dina@example.com"`
+	Name  string `json:"name" validate:"required,max=100" example:"Dina"`
+	// Password is hashed with bcrypt, which refuses passwords longer than 72
+	// bytes. The service checks the length in bytes, a validator max counts
+	// characters.
+	Password string `json:"password" validate:"required,min=8"`
+}
+
+type LoginPayload struct {
+	Email    string `json:"email" validate:"required,email,max=254" example:"dina@example.com"`
+	Password string `json:"password" validate:"required"`
+}
+
+type RefreshPayload struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type UserDetails struct {
+	UserID    int64  `json:"user_id"`
+	Email     string `json:"email" example:"dina@example.com"`
+	Name      string `json:"name" example:"Dina"`
+	CreatedAt string `json:"created_at" example:"2026-10-18T09:30:00Z"`
+}
+
+type TokenDetails struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type" example:"Bearer"`
+	// ExpiresIn is the lifetime of the access token in seconds.
+	ExpiresIn int64 `json:"expires_in" example:"900"`
+}
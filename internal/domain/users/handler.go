@@ -0,0 +1,177 @@
+package users
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type RegisterHandler func(context.Context, *RegisterPayload) (*UserDetails, error)
+type LoginHandler func(context.Context, *LoginPayload) (*TokenDetails, error)
+type RefreshHandler func(context.Context, *RefreshPayload) (*TokenDetails, error)
+type LogoutHandler func(context.Context, *RefreshPayload) error
+type MeHandler func(context.Context) (*UserDetails, error)
+
+// Register godoc
+//
+//	@Summary	Create a user account
+//	@Tags		Auth
+//	@Accept		json
+//	@Produce	json
+//	@Security
+//	@Param		body	body		RegisterPayload			true	"Account details"
+//	@Success	201		{object}	UserDetails				"User successfully registered"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request or email already registered"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/auth/register [post]
+func HandleRegister(handler RegisterHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		payload := &RegisterPayload{}
+
+		if err := c.Bind(payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, payload)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusCreated, data, "User successfully registered")
+	}
+}
+
+// Login godoc
+//
+//	@Summary		Log in with email and password
+//	@Description	Returns a short lived access token for the Authorization header and a refresh token to get a new pair with.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Security
+//	@Param			body	body		LoginPayload			true	"Credentials"
+//	@Success		200		{object}	TokenDetails			"Logged in successfully"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		401		{object}	httpres.ErrorResponse	"Invalid email or password"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/auth/login [post]
+func HandleLogin(handler LoginHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		payload := &LoginPayload{}
+
+		if err := c.Bind(payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, payload)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "Logged in successfully")
+	}
+}
+
+// Refresh godoc
+//
+//	@Summary		Trade a refresh token for a new pair of tokens
+//	@Description	A refresh token can be used once.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Security
+//	@Param			body	body		RefreshPayload			true	"Refresh token"
+//	@Success		200		{object}	TokenDetails			"Tokens refreshed successfully"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		401		{object}	httpres.ErrorResponse	"Invalid, expired or spent refresh token"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/auth/refresh [post]
+func HandleRefresh(handler RefreshHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		payload := &RefreshPayload{}
+
+		if err := c.Bind(payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, payload)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "Tokens refreshed successfully")
+	}
+}
+
+// Logout godoc
+//
+//	@Summary	Revoke a refresh token
+//	@Tags		Auth
+//	@Accept		json
+//	@Produce	json
+//	@Security
+//	@Param		body	body		RefreshPayload			true	"Refresh token"
+//	@Success	200		{object}	httpres.BaseResponse	"Logged out successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	401		{object}	httpres.ErrorResponse	"Invalid or expired refresh token"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/auth/logout [post]
+func HandleLogout(handler LogoutHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		payload := &RefreshPayload{}
+
+		if err := c.Bind(payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Logged out successfully")
+	}
+}
+
+// Me godoc
+//
+//	@Summary	Get the logged in user
+//	@Tags		Auth
+//	@Produce	json
+//	@Success	200	{object}	UserDetails				"User fetched successfully"
+//	@Failure	401	{object}	httpres.ErrorResponse	"Missing or invalid access token"
+//	@Failure	500	{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/users/me [get]
+func HandleMe(handler MeHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		data, err := handler(ctx)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "User fetched successfully")
+	}
+}
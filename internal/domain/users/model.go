@@ -0,0 +1,11 @@
+package users
+
+import "time"
+
+type Users struct {
+	UserID       int64     `db:"user_id"`
+	Email        string    `db:"email"`
+	Name         string    `db:"name"`
+	PasswordHash string    `db:"password_hash"`
+	CreatedAt    time.Time `db:"created_at"`
+}
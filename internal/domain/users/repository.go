@@ -0,0 +1,175 @@
+package users
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/jmoiron/sqlx"
+)
+
+var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+type UsersRepository interface {
+	GetByID(context.Context, int64) (*Users, error)
+	GetByEmail(context.Context, string) (*Users, error)
+	Add(context.Context, *RegisterPayload, string) (int64, error)
+	AddRefreshToken(context.Context, string, int64, time.Duration) error
+	RotateRefreshToken(context.Context, string, string, int64, time.Duration) error
+	RevokeRefreshToken(context.Context, string) error
+}
+
+type usersRepository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) UsersRepository {
+	return &usersRepository{
+		db: db,
+	}
+}
+
+func (r *usersRepository) GetByID(ctx context.Context, userID int64) (resp *Users, err error) {
+	stmt, args, _ := pgSquirell.Select("user_id", "email", "name", "password_hash", "created_at").From("users").Where(squirrel.Eq{"user_id": userID, "deleted_at": nil}).ToSql()
+
+	resp = &Users{}
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).StructScan(resp)
+	if err != nil && err != sql.ErrNoRows {
+		return resp, err
+	} else if err == sql.ErrNoRows {
+		return resp, exceptions.NewNotFoundError("users not found")
+	}
+
+	return resp, nil
+}
+
+// GetByEmail finds a user by email address, ignoring case.
+func (r *usersRepository) GetByEmail(ctx context.Context, email string) (resp *Users, err error) {
+	stmt, args, _ := pgSquirell.Select("user_id", "email", "name", "password_hash", "created_at").
+						From("users").
+						Where(squirrel.And{squirrel.Eq{"deleted_at": nil}, squirrel.Expr("lower(email) = lower(?)", email)}).
+						ToSql()
+
+	resp = &Users{}
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).StructScan(resp)
+	if err != nil && err != sql.ErrNoRows {
+		return resp, err
+	} else if err == sql.ErrNoRows {
+		return resp, exceptions.NewNotFoundError("users not found")
+	}
+
+	return resp, nil
+}
+
+func (r *usersRepository) Add(ctx context.Context, payload *RegisterPayload, passwordHash string) (userID int64, err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer tx.Rollback()
+
+	var count int64
+
+	stmt, args, _ := pgSquirell.Select("count(*)").From("users").Where(squirrel.And{squirrel.Eq{"deleted_at": nil}, squirrel.Expr("lower(email) = lower(?)", payload.Email)}).ToSql()
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	} else if count > 0 {
+		return 0, exceptions.NewInvariantError("email is already registered")
+	}
+
+	stmt, args, _ = pgSquirell.Insert("users").Columns("email", "name", "password_hash").Values(payload.Email, payload.Name, passwordHash).Suffix("RETURNING user_id").ToSql()
+
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return userID, nil
+}
+
+func (r *usersRepository) AddRefreshToken(ctx context.Context, tokenID string, userID int64, ttl time.Duration) (err error) {
+	stmt, args, _ := pgSquirell.Insert("refresh_tokens").
+						Columns("token_id", "user_id", "expires_at").
+						Values(tokenID, userID, squirrel.Expr("NOW() + make_interval(secs => ?)", ttl.Seconds())).
+						ToSql()
+
+	_, err = r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RotateRefreshToken spends a refresh token and stores the one replacing it.
+// A token that was already spent, revoked or expired, or that belongs to a
+// deleted user, is refused.
+func (r *usersRepository) RotateRefreshToken(ctx context.Context, tokenID string, newTokenID string, userID int64, ttl time.Duration) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	stmt, args, _ := pgSquirell.Update("refresh_tokens").
+						Set("revoked_at", squirrel.Expr("NOW()")).
+						From("users u").
+						Where(squirrel.And{
+							squirrel.Expr("u.user_id = refresh_tokens.user_id"),
+							squirrel.Eq{"u.deleted_at": nil, "refresh_tokens.token_id": tokenID, "refresh_tokens.user_id": userID, "refresh_tokens.revoked_at": nil},
+							squirrel.Expr("refresh_tokens.expires_at > NOW()"),
+						}).
+						ToSql()
+
+	result, err := tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewUnauthorizedError("refresh token is no longer valid")
+	}
+
+	stmt, args, _ = pgSquirell.Insert("refresh_tokens").
+						Columns("token_id", "user_id", "expires_at").
+						Values(newTokenID, userID, squirrel.Expr("NOW() + make_interval(secs => ?)", ttl.Seconds())).
+						ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *usersRepository) RevokeRefreshToken(ctx context.Context, tokenID string) (err error) {
+	stmt, args, _ := pgSquirell.Update("refresh_tokens").
+						Set("revoked_at", squirrel.Expr("NOW()")).
+						Where(squirrel.Eq{"token_id": tokenID, "revoked_at": nil}).
+						ToSql()
+
+	_, err = r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// dummyHash is compared against when the email of a login is unknown, so a
+// login takes as long whether or not the account exists.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("sosmed-todolist"), bcrypt.DefaultCost)
+
+// maxPasswordBytes is the longest password bcrypt hashes.
+const maxPasswordBytes = 72
+
+type UsersService interface {
+	Register(context.Context, *RegisterPayload) (*UserDetails, error)
+	Login(context.Context, *LoginPayload) (*TokenDetails, error)
+	Refresh(context.Context, *RefreshPayload) (*TokenDetails, error)
+	Logout(context.Context, *RefreshPayload) error
+	Me(context.Context) (*UserDetails, error)
+}
+
+type usersService struct {
+	repo   UsersRepository
+	tokens *auth.Tokens
+}
+
+func NewService(r UsersRepository, tokens *auth.Tokens) *usersService {
+	return &usersService{repo: r, tokens: tokens}
+}
+
+func (svc *usersService) Register(ctx context.Context, payload *RegisterPayload) (userDetails *UserDetails, err error) {
+	if err = checkPassword(payload.Password); err != nil {
+		return userDetails, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(payload.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return userDetails, err
+	}
+
+	userID, err := svc.repo.Add(ctx, payload, string(hash))
+	if err != nil {
+		return userDetails, err
+	}
+
+	user, err := svc.repo.GetByID(ctx, userID)
+	if err != nil {
+		return userDetails, err
+	}
+
+	return toUserDetails(user), nil
+}
+
+func (svc *usersService) Login(ctx context.Context, payload *LoginPayload) (tokenDetails *TokenDetails, err error) {
+	if err = checkPassword(payload.Password); err != nil {
+		return tokenDetails, err
+	}
+
+	user, err := svc.repo.GetByEmail(ctx, payload.Email)
+	if err != nil && !errors.As(err, &exceptions.NotFoundError{}) {
+		return tokenDetails, err
+	} else if err != nil {
+		bcrypt.CompareHashAndPassword(dummyHash, []byte(payload.Password))
+		return tokenDetails, exceptions.NewUnauthorizedError("invalid email or password")
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(payload.Password)); err != nil {
+		return tokenDetails, exceptions.NewUnauthorizedError("invalid email or password")
+	}
+
+	tokenDetails, refreshClaims, err := svc.issue(user.UserID)
+	if err != nil {
+		return tokenDetails, err
+	}
+
+	err = svc.repo.AddRefreshToken(ctx, refreshClaims.ID, user.UserID, lifetime(refreshClaims))
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenDetails, nil
+}
+
+// Refresh trades a refresh token for a new pair of tokens, the refresh token
+// cannot be used again.
+func (svc *usersService) Refresh(ctx context.Context, payload *RefreshPayload) (tokenDetails *TokenDetails, err error) {
+	claims, err := svc.tokens.Verify(payload.RefreshToken, auth.RefreshToken)
+	if err != nil {
+		return tokenDetails, exceptions.NewUnauthorizedError("invalid or expired refresh token")
+	}
+
+	userID, _ := claims.UserID()
+
+	tokenDetails, refreshClaims, err := svc.issue(userID)
+	if err != nil {
+		return tokenDetails, err
+	}
+
+	err = svc.repo.RotateRefreshToken(ctx, claims.ID, refreshClaims.ID, userID, lifetime(refreshClaims))
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenDetails, nil
+}
+
+// Logout revokes a refresh token. Access tokens stay valid until they expire,
+// which is why they are short lived.
+func (svc *usersService) Logout(ctx context.Context, payload *RefreshPayload) (err error) {
+	claims, err := svc.tokens.Verify(payload.RefreshToken, auth.RefreshToken)
+	if err != nil {
+		return exceptions.NewUnauthorizedError("invalid or expired refresh token")
+	}
+
+	err = svc.repo.RevokeRefreshToken(ctx, claims.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *usersService) Me(ctx context.Context) (userDetails *UserDetails, err error) {
+	caller, ok := auth.FromContext(ctx)
+	if !ok {
+		return userDetails, exceptions.NewUnauthorizedError("missing bearer token")
+	}
+
+	user, err := svc.repo.GetByID(ctx, caller.ID)
+	if err != nil {
+		return userDetails, err
+	}
+
+	return toUserDetails(user), nil
+}
+
+func checkPassword(password string) error {
+	if len(password) > maxPasswordBytes {
+		return exceptions.NewInvariantError(fmt.Sprintf("password must be at most %d bytes", maxPasswordBytes))
+	}
+
+	return nil
+}
+
+// issue signs a new pair of tokens for a user. The claims of the refresh
+// token are returned so it can be stored.
+func (svc *usersService) issue(userID int64) (*TokenDetails, *auth.Claims, error) {
+	accessToken, accessClaims, err := svc.tokens.Issue(auth.AccessToken, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refreshToken, refreshClaims, err := svc.tokens.Issue(auth.RefreshToken, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokenDetails := &TokenDetails{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(lifetime(accessClaims).Seconds()),
+	}
+
+	return tokenDetails, refreshClaims, nil
+}
+
+func lifetime(claims *auth.Claims) time.Duration {
+	return claims.ExpiresAt.Sub(claims.IssuedAt.Time)
+}
+
+func toUserDetails(user *Users) *UserDetails {
+	return &UserDetails{
+		UserID:    user.UserID,
+		Email:     user.Email,
+		Name:      user.Name,
+		CreatedAt: user.CreatedAt.Format(time.RFC3339),
+	}
+}
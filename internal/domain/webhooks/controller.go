@@ -0,0 +1,32 @@
+package webhooks
+
+import (
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type WebhooksController struct {
+	svc WebhooksService
+}
+
+func NewController(svc WebhooksService) *WebhooksController {
+	return &WebhooksController{
+		svc: svc,
+	}
+}
+
+const (
+	webhooksBasepath = "/webhooks"
+)
+
+func (con *WebhooksController) Route(grp *echo.Group){
+	subrouter := grp.Group(webhooksBasepath, middleware.RequireScopes(auth.ScopeWebhooksRead, auth.ScopeWebhooksWrite))
+
+	subrouter.GET("", HandleGetAllWebhooks(con.svc.GetAll))
+	subrouter.GET("/:webhook_id", HandleGetOneWebhooks(con.svc.GetOne))
+	subrouter.POST("", HandleCreateWebhooks(con.svc.Create))
+	subrouter.PUT("/:webhook_id", HandleUpdateWebhooks(con.svc.Update))
+	subrouter.DELETE("/:webhook_id", HandleDeleteWebhooks(con.svc.Delete))
+	subrouter.GET("/:webhook_id/deliveries", HandleGetDeliveries(con.svc.GetDeliveries))
+}
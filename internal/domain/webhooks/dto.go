@@ -0,0 +1,76 @@
+package webhooks
+
+import (
+	"encoding/json"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/httpres"
+)
+
+const (
+	DeliveryPending   = "Pending"
+	DeliverySucceeded = "Succeeded"
+	DeliveryFailed    = "Failed"
+)
+
+type WebhookRequestParams struct {
+	WebhookID string `param:"webhook_id" validate:"required"`
+}
+
+type WebhookRequestPayload struct {
+	URL    string   `json:"url" validate:"required,url,max=2048" example:"https://hooks.example.com/sosmed"`
+	Secret string   `json:"secret" validate:"required,min=16,max=200" example:"change-me-to-a-long-random-string"`
+	Events []string `json:"events" validate:"required,min=1,dive,required,max=50" example:"task.created,task.completed"`
+	Active *bool    `json:"active" example:"true"`
+}
+
+type DeliveryRequestQuery struct {
+	Limit uint64 `query:"limit" validate:"omitempty,min=1,max=100"`
+	Page  uint64 `query:"page" validate:"omitempty,min=1"`
+}
+
+// WebhookDetails leaves out the secret, it is never sent back once set.
+type WebhookDetails struct {
+	WebhookID int64    `json:"webhook_id"`
+	URL       string   `json:"url" example:"https://hooks.example.com/sosmed"`
+	Events    []string `json:"events" example:"task.created,task.completed"`
+	Active    bool     `json:"active"`
+	CreatedAt string   `json:"created_at" example:"2026-10-18T09:30:00Z"`
+	UpdatedAt string   `json:"updated_at" example:"2026-10-18T09:30:00Z"`
+}
+
+type ListofWebhooks struct {
+	Webhooks []*WebhookDetails `json:"webhooks"`
+}
+
+type AttemptDetails struct {
+	Attempt     int    `json:"attempt" example:"1"`
+	StatusCode  *int64 `json:"status_code" example:"200"`
+	Error       string `json:"error"`
+	DurationMS  int64  `json:"duration_ms" example:"84"`
+	AttemptedAt string `json:"attempted_at" example:"2026-10-18T09:30:01Z"`
+}
+
+type DeliveryDetails struct {
+	DeliveryID    int64             `json:"delivery_id"`
+	Event         string            `json:"event" example:"task.created"`
+	Payload       json.RawMessage   `json:"payload" swaggertype:"object"`
+	Status        string            `json:"status" enums:"Pending,Succeeded,Failed"`
+	Attempts      []*AttemptDetails `json:"attempts"`
+	NextAttemptAt *string           `json:"next_attempt_at" example:"2026-10-18T09:31:01Z"`
+	DeliveredAt   *string           `json:"delivered_at" example:"2026-10-18T09:30:01Z"`
+	CreatedAt     string            `json:"created_at" example:"2026-10-18T09:30:00Z"`
+}
+
+type ListofDeliveries struct {
+	Deliveries []*DeliveryDetails     `json:"deliveries"`
+	Meta       httpres.ListPagination `json:"meta"`
+}
+
+// WebhookEventPayload is the JSON body posted to a subscription. Data is the
+// resource the event is about, as it was right before it was deleted for the
+// delete events.
+type WebhookEventPayload struct {
+	Event      string `json:"event" example:"task.created"`
+	OccurredAt string `json:"occurred_at" example:"2026-10-18T09:30:00Z"`
+	Data       any    `json:"data"`
+}
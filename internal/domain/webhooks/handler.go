@@ -0,0 +1,226 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type GetAllWebhooksHandler func(context.Context) (*ListofWebhooks, error)
+type GetOneWebhooksHandler func(context.Context, *WebhookRequestParams) (*WebhookDetails, error)
+type CreateWebhooksHandler func(context.Context, *WebhookRequestPayload) error
+type UpdateWebhooksHandler func(context.Context, *WebhookRequestParams, *WebhookRequestPayload) error
+type DeleteWebhooksHandler func(context.Context, *WebhookRequestParams) error
+type GetDeliveriesHandler func(context.Context, *WebhookRequestParams, *DeliveryRequestQuery) (*ListofDeliveries, error)
+
+// Get All Webhooks godoc
+//
+//	@Summary	Get all webhook subscriptions
+//	@Tags		Webhook
+//	@Produce	json
+//	@Success	200		{object}	ListofWebhooks			"Successfully fetched all webhooks"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/webhooks [get]
+func HandleGetAllWebhooks(handler GetAllWebhooksHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		data, err := handler(ctx)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "All webhooks fetched successfully")
+	}
+}
+
+// Get One Webhook godoc
+//
+//	@Summary	Get a single webhook subscription by ID
+//	@Tags		Webhook
+//	@Produce	json
+//	@Param		id	path	string	true	"Webhook ID"
+//	@Success	200		{object}	WebhookDetails			"Successfully fetched the webhook"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Webhook not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/webhooks/{id} [get]
+func HandleGetOneWebhooks(handler GetOneWebhooksHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &WebhookRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "Webhook fetched successfully")
+	}
+}
+
+// Create Webhook godoc
+//
+//	@Summary		Subscribe a URL to events
+//	@Description	Every delivery is signed: X-Webhook-Signature is "sha256=" followed by the hex HMAC-SHA256 of "<X-Webhook-Timestamp>.<body>" keyed with the secret.
+//	@Description	Events: task.created, task.updated, task.completed, task.deleted, brand.created, brand.updated, brand.deleted, platform.created, platform.updated, platform.deleted.
+//	@Tags			Webhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		WebhookRequestPayload	true	"Webhook Request Payload"
+//	@Success		201		{object}	httpres.BaseResponse	"Webhook successfully created"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Role may not manage webhooks"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/webhooks [post]
+func HandleCreateWebhooks(handler CreateWebhooksHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		payload := &WebhookRequestPayload{}
+
+		if err := c.Bind(payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusCreated, nil, "Webhook successfully created")
+	}
+}
+
+// Update Webhook godoc
+//
+//	@Summary	Update an existing webhook subscription
+//	@Tags		Webhook
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path	string					true	"Webhook ID"
+//	@Param		body	body	WebhookRequestPayload	true	"Updated webhook details"
+//	@Success	200		{object}	httpres.BaseResponse	"Webhook updated successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not manage webhooks"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Webhook not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/webhooks/{id} [put]
+func HandleUpdateWebhooks(handler UpdateWebhooksHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &WebhookRequestParams{}
+		payload := &WebhookRequestPayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Webhook updated successfully")
+	}
+}
+
+// Delete Webhook godoc
+//
+//	@Summary	Delete a webhook subscription
+//	@Tags		Webhook
+//	@Produce	json
+//	@Param		id	path	string	true	"Webhook ID"
+//	@Success	200		{object}	httpres.BaseResponse	"Webhook deleted successfully"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Role may not manage webhooks"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Webhook not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/webhooks/{id} [delete]
+func HandleDeleteWebhooks(handler DeleteWebhooksHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &WebhookRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Webhook deleted successfully")
+	}
+}
+
+// Get Deliveries godoc
+//
+//	@Summary	Get the deliveries of a webhook subscription with every attempt
+//	@Tags		Webhook
+//	@Produce	json
+//	@Param		id		path		string	true	"Webhook ID"
+//	@Param		limit	query		int		false	"Number of entities per page"
+//	@Param		page	query		int		false	"Page number"
+//	@Success	200		{object}	ListofDeliveries		"Successfully fetched all deliveries"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	404		{object}	httpres.ErrorResponse	"Webhook not found"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/webhooks/{id}/deliveries [get]
+func HandleGetDeliveries(handler GetDeliveriesHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &WebhookRequestParams{}
+		query := &DeliveryRequestQuery{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindQueryParams(c, query); err != nil {
+			return err
+		}
+
+		if err := c.Validate(query); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, params, query)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "All deliveries fetched successfully")
+	}
+}
@@ -0,0 +1,48 @@
+package webhooks
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+)
+
+type Webhooks struct {
+	WebhookID int64             `db:"webhook_id"`
+	URL       string            `db:"url"`
+	Secret    string            `db:"secret"`
+	Events    utils.StringArray `db:"events"`
+	Active    bool              `db:"active"`
+	CreatedAt time.Time         `db:"created_at"`
+	UpdatedAt time.Time         `db:"updated_at"`
+}
+
+type Deliveries struct {
+	DeliveryID    int64        `db:"delivery_id"`
+	WebhookID     int64        `db:"webhook_id"`
+	Event         string       `db:"event"`
+	Payload       []byte       `db:"payload"`
+	Status        string       `db:"status"`
+	Attempts      int          `db:"attempts"`
+	NextAttemptAt sql.NullTime `db:"next_attempt_at"`
+	DeliveredAt   sql.NullTime `db:"delivered_at"`
+	CreatedAt     time.Time    `db:"created_at"`
+}
+
+// PendingDeliveries is a delivery claimed for sending, with where and how to
+// send it.
+type PendingDeliveries struct {
+	Deliveries
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+}
+
+type Attempts struct {
+	AttemptID   int64         `db:"attempt_id"`
+	DeliveryID  int64         `db:"delivery_id"`
+	Attempt     int           `db:"attempt"`
+	StatusCode  sql.NullInt64 `db:"status_code"`
+	Error       string        `db:"error"`
+	DurationMS  int64         `db:"duration_ms"`
+	AttemptedAt time.Time     `db:"attempted_at"`
+}
@@ -0,0 +1,272 @@
+package webhooks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/jmoiron/sqlx"
+)
+
+var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+var webhooksColumns = []string{"webhook_id", "url", "secret", "events", "active", "created_at", "updated_at"}
+
+type WebhooksRepository interface {
+	GetAll(context.Context, int64) ([]*Webhooks, error)
+	GetByID(context.Context, int64, *WebhookRequestParams) (*Webhooks, error)
+	Add(context.Context, int64, *WebhookRequestPayload) error
+	Update(context.Context, int64, *WebhookRequestParams, *WebhookRequestPayload) error
+	Delete(context.Context, int64, *WebhookRequestParams) error
+	GetDeliveries(context.Context, *WebhookRequestParams, *DeliveryRequestQuery) ([]*Deliveries, error)
+	CountDeliveries(context.Context, *WebhookRequestParams) (uint64, error)
+	GetAttempts(context.Context, []int64) ([]*Attempts, error)
+	Enqueue(context.Context, int64, string, []byte) error
+	ClaimDeliveries(context.Context, int, time.Duration) ([]*PendingDeliveries, error)
+	RecordAttempt(context.Context, *PendingDeliveries, *Attempts) error
+}
+
+type webhooksRepository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) WebhooksRepository {
+	return &webhooksRepository{
+		db: db,
+	}
+}
+
+func (r *webhooksRepository) GetAll(ctx context.Context, workspaceID int64) (resp []*Webhooks, err error) {
+	stmt, args, _ := pgSquirell.Select(webhooksColumns...).
+						From("webhooks").
+						Where(squirrel.Eq{"workspace_id": workspaceID, "deleted_at": nil}).
+						OrderBy("webhook_id ASC").
+						ToSql()
+
+	resp = []*Webhooks{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (r *webhooksRepository) GetByID(ctx context.Context, workspaceID int64, params *WebhookRequestParams) (resp *Webhooks, err error) {
+	stmt, args, _ := pgSquirell.Select(webhooksColumns...).
+						From("webhooks").
+						Where(squirrel.Eq{"webhook_id": params.WebhookID, "workspace_id": workspaceID, "deleted_at": nil}).
+						ToSql()
+
+	resp = &Webhooks{}
+
+	err = r.db.GetContext(ctx, resp, stmt, args...)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	} else if err == sql.ErrNoRows {
+		return nil, exceptions.NewNotFoundError("webhooks not found")
+	}
+
+	return resp, nil
+}
+
+func (r *webhooksRepository) Add(ctx context.Context, workspaceID int64, payload *WebhookRequestPayload) (err error) {
+	stmt, args, _ := pgSquirell.Insert("webhooks").Columns("workspace_id", "url", "secret", "events", "active").Values(workspaceID, payload.URL, payload.Secret, payload.Events, *payload.Active).ToSql()
+
+	_, err = r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *webhooksRepository) Update(ctx context.Context, workspaceID int64, params *WebhookRequestParams, payload *WebhookRequestPayload) (err error) {
+	stmt, args, _ := pgSquirell.Update("webhooks").SetMap(map[string]interface{}{
+		"url":        payload.URL,
+		"secret":     payload.Secret,
+		"events":     payload.Events,
+		"active":     *payload.Active,
+		"updated_at": squirrel.Expr("NOW()"),
+	}).Where(squirrel.Eq{"webhook_id": params.WebhookID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
+
+	result, err := r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("webhooks not found")
+	}
+
+	return nil
+}
+
+func (r *webhooksRepository) Delete(ctx context.Context, workspaceID int64, params *WebhookRequestParams) (err error) {
+	stmt, args, _ := pgSquirell.Update("webhooks").SetMap(map[string]interface{}{
+		"deleted_at": squirrel.Expr("NOW()"),
+	}).Where(squirrel.Eq{"webhook_id": params.WebhookID, "workspace_id": workspaceID, "deleted_at": nil}).ToSql()
+
+	result, err := r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("webhooks not found")
+	}
+
+	return nil
+}
+
+func (r *webhooksRepository) GetDeliveries(ctx context.Context, params *WebhookRequestParams, query *DeliveryRequestQuery) (resp []*Deliveries, err error) {
+	stmt, args, _ := pgSquirell.Select("delivery_id", "webhook_id", "event", "payload", "status", "attempts", "next_attempt_at", "delivered_at", "created_at").
+						From("webhook_deliveries").
+						Where(squirrel.Eq{"webhook_id": params.WebhookID}).
+						OrderBy("created_at DESC", "delivery_id DESC").
+						Limit(query.Limit).Offset((query.Page - 1) * query.Limit).
+						ToSql()
+
+	resp = []*Deliveries{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (r *webhooksRepository) CountDeliveries(ctx context.Context, params *WebhookRequestParams) (resp uint64, err error) {
+	stmt, args, _ := pgSquirell.Select("count(*)").From("webhook_deliveries").Where(squirrel.Eq{"webhook_id": params.WebhookID}).ToSql()
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).Scan(&resp)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (r *webhooksRepository) GetAttempts(ctx context.Context, deliveryIDs []int64) (resp []*Attempts, err error) {
+	resp = []*Attempts{}
+	if len(deliveryIDs) == 0 {
+		return resp, nil
+	}
+
+	stmt, args, _ := pgSquirell.Select("attempt_id", "delivery_id", "attempt", "status_code", "error", "duration_ms", "attempted_at").
+						From("webhook_delivery_attempts").
+						Where(squirrel.Eq{"delivery_id": deliveryIDs}).
+						OrderBy("delivery_id ASC", "attempt ASC").
+						ToSql()
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// Enqueue queues a delivery of the event to every active webhook of the
+// workspace subscribed to it.
+func (r *webhooksRepository) Enqueue(ctx context.Context, workspaceID int64, event string, payload []byte) (err error) {
+	subscribers := squirrel.Select("webhook_id").
+						Column(squirrel.Expr("?", event)).
+						Column(squirrel.Expr("?::jsonb", string(payload))).
+						From("webhooks").
+						Where(squirrel.And{squirrel.Eq{"workspace_id": workspaceID, "deleted_at": nil, "active": true}, squirrel.Expr("? = ANY(events)", event)})
+
+	stmt, args, _ := pgSquirell.Insert("webhook_deliveries").Columns("webhook_id", "event", "payload").Select(subscribers).ToSql()
+
+	_, err = r.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ClaimDeliveries picks up to limit pending deliveries that are ready to be
+// attempted and leases them, the same way reminder deliveries are claimed.
+// Deliveries of webhooks deleted or disabled meanwhile are left alone.
+func (r *webhooksRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) (resp []*PendingDeliveries, err error) {
+	ready := squirrel.Select("d.delivery_id").
+				From("webhook_deliveries d").
+				Join("webhooks w ON d.webhook_id = w.webhook_id").
+				Where(squirrel.And{squirrel.Eq{"d.status": DeliveryPending, "w.deleted_at": nil, "w.active": true}, squirrel.Expr("d.next_attempt_at <= NOW()")}).
+				OrderBy("d.next_attempt_at ASC").
+				Limit(uint64(limit)).
+				Suffix("FOR UPDATE OF d SKIP LOCKED")
+
+	stmt, args, _ := pgSquirell.Update("webhook_deliveries d").SetMap(map[string]interface{}{
+		"next_attempt_at": squirrel.Expr("NOW() + make_interval(secs => ?)", lease.Seconds()),
+		"updated_at":      squirrel.Expr("NOW()"),
+	}).From("webhooks w").
+		Where(squirrel.And{squirrel.Expr("d.webhook_id = w.webhook_id"), squirrel.Expr("d.delivery_id IN (?)", ready)}).
+		Suffix("RETURNING d.delivery_id, d.webhook_id, d.event, d.payload, d.status, d.attempts, d.next_attempt_at, d.delivered_at, d.created_at, w.url, w.secret").
+		ToSql()
+
+	resp = []*PendingDeliveries{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// RecordAttempt stores an attempt to send a delivery together with the new
+// state of the delivery.
+func (r *webhooksRepository) RecordAttempt(ctx context.Context, delivery *PendingDeliveries, attempt *Attempts) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var stmt string
+	var args []any
+
+	stmt, args, _ = pgSquirell.Insert("webhook_delivery_attempts").
+						Columns("delivery_id", "attempt", "status_code", "error", "duration_ms").
+						Values(delivery.DeliveryID, attempt.Attempt, attempt.StatusCode, attempt.Error, attempt.DurationMS).
+						ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	setMap := map[string]interface{}{
+		"status":          delivery.Status,
+		"attempts":        delivery.Attempts,
+		"next_attempt_at": delivery.NextAttemptAt,
+		"updated_at":      squirrel.Expr("NOW()"),
+	}
+
+	if delivery.Status == DeliverySucceeded {
+		setMap["delivered_at"] = squirrel.Expr("NOW()")
+	}
+
+	stmt, args, _ = pgSquirell.Update("webhook_deliveries").SetMap(setMap).Where(squirrel.Eq{"delivery_id": delivery.DeliveryID}).ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
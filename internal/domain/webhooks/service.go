@@ -0,0 +1,359 @@
+package webhooks
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/events"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/agungramananda/sosmed-todolist/internal/common/httpres"
+	"github.com/agungramananda/sosmed-todolist/internal/common/webhook"
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/rs/zerolog"
+)
+
+const (
+	deliverBatchSize = 20
+
+	// deliveryLease must outlast sending a whole batch with the client timeout.
+	deliveryLease = 5 * time.Minute
+
+	maxDeliveryAttempts = 8
+	retryBaseDelay      = 30 * time.Second
+	retryMaxDelay       = 6 * time.Hour
+)
+
+type WebhooksService interface {
+	GetAll(context.Context) (*ListofWebhooks, error)
+	GetOne(context.Context, *WebhookRequestParams) (*WebhookDetails, error)
+	Create(context.Context, *WebhookRequestPayload) error
+	Update(context.Context, *WebhookRequestParams, *WebhookRequestPayload) error
+	Delete(context.Context, *WebhookRequestParams) error
+	GetDeliveries(context.Context, *WebhookRequestParams, *DeliveryRequestQuery) (*ListofDeliveries, error)
+	Publish(context.Context, string, any)
+	Dispatch(context.Context) error
+}
+
+type webhooksService struct {
+	repo   WebhooksRepository
+	client *webhook.Client
+	logger *zerolog.Logger
+}
+
+func NewService(r WebhooksRepository, client *webhook.Client, logger *zerolog.Logger) *webhooksService {
+	return &webhooksService{repo: r, client: client, logger: logger}
+}
+
+func (svc *webhooksService) GetAll(ctx context.Context) (listOfWebhooks *ListofWebhooks, err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return listOfWebhooks, err
+	}
+
+	webhooks, err := svc.repo.GetAll(ctx, caller.WorkspaceID)
+	if err != nil {
+		return listOfWebhooks, err
+	}
+
+	listOfWebhooks = &ListofWebhooks{
+		Webhooks: []*WebhookDetails{},
+	}
+
+	for _, hook := range webhooks {
+		listOfWebhooks.Webhooks = append(listOfWebhooks.Webhooks, toWebhookDetails(hook))
+	}
+
+	return listOfWebhooks, nil
+}
+
+func (svc *webhooksService) GetOne(ctx context.Context, params *WebhookRequestParams) (webhookDetails *WebhookDetails, err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return webhookDetails, err
+	}
+
+	hook, err := svc.repo.GetByID(ctx, caller.WorkspaceID, params)
+	if err != nil {
+		return webhookDetails, err
+	}
+
+	return toWebhookDetails(hook), nil
+}
+
+func (svc *webhooksService) Create(ctx context.Context, payload *WebhookRequestPayload) (err error) {
+	caller, err := auth.Authorize(ctx, auth.PermManageWebhooks)
+	if err != nil {
+		return err
+	}
+
+	if err = preparePayload(payload); err != nil {
+		return err
+	}
+
+	err = svc.repo.Add(ctx, caller.WorkspaceID, payload)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *webhooksService) Update(ctx context.Context, params *WebhookRequestParams, payload *WebhookRequestPayload) (err error) {
+	caller, err := auth.Authorize(ctx, auth.PermManageWebhooks)
+	if err != nil {
+		return err
+	}
+
+	if err = preparePayload(payload); err != nil {
+		return err
+	}
+
+	err = svc.repo.Update(ctx, caller.WorkspaceID, params, payload)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *webhooksService) Delete(ctx context.Context, params *WebhookRequestParams) (err error) {
+	caller, err := auth.Authorize(ctx, auth.PermManageWebhooks)
+	if err != nil {
+		return err
+	}
+
+	err = svc.repo.Delete(ctx, caller.WorkspaceID, params)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc *webhooksService) GetDeliveries(ctx context.Context, params *WebhookRequestParams, query *DeliveryRequestQuery) (listOfDeliveries *ListofDeliveries, err error) {
+	caller, err := auth.Member(ctx)
+	if err != nil {
+		return &ListofDeliveries{}, err
+	}
+
+	// the deliveries are only looked up by webhook once it is known to be
+	// one of the caller's workspace
+	if _, err = svc.repo.GetByID(ctx, caller.WorkspaceID, params); err != nil {
+		return &ListofDeliveries{}, err
+	}
+
+	limit := int(query.Limit)
+	page := int(query.Page)
+	utils.SetDefaultPagination(&limit, &page)
+
+	repoQuery := &DeliveryRequestQuery{
+		Limit: uint64(limit),
+		Page:  uint64(page),
+	}
+
+	deliveries, err := svc.repo.GetDeliveries(ctx, params, repoQuery)
+	if err != nil {
+		return &ListofDeliveries{}, err
+	}
+
+	deliveryIDs := []int64{}
+	for _, delivery := range deliveries {
+		deliveryIDs = append(deliveryIDs, delivery.DeliveryID)
+	}
+
+	attempts, err := svc.repo.GetAttempts(ctx, deliveryIDs)
+	if err != nil {
+		return &ListofDeliveries{}, err
+	}
+
+	attemptsByDelivery := map[int64][]*AttemptDetails{}
+	for _, attempt := range attempts {
+		details := &AttemptDetails{
+			Attempt:     attempt.Attempt,
+			Error:       attempt.Error,
+			DurationMS:  attempt.DurationMS,
+			AttemptedAt: attempt.AttemptedAt.Format(time.RFC3339),
+		}
+		if attempt.StatusCode.Valid {
+			details.StatusCode = &attempt.StatusCode.Int64
+		}
+
+		attemptsByDelivery[attempt.DeliveryID] = append(attemptsByDelivery[attempt.DeliveryID], details)
+	}
+
+	listOfDeliveries = &ListofDeliveries{
+		Deliveries: []*DeliveryDetails{},
+		Meta: httpres.ListPagination{
+			Limit: repoQuery.Limit,
+			Page:  repoQuery.Page,
+		},
+	}
+
+	for _, delivery := range deliveries {
+		details := &DeliveryDetails{
+			DeliveryID: delivery.DeliveryID,
+			Event:      delivery.Event,
+			Payload:    delivery.Payload,
+			Status:     delivery.Status,
+			Attempts:   attemptsByDelivery[delivery.DeliveryID],
+			CreatedAt:  delivery.CreatedAt.Format(time.RFC3339),
+		}
+		if details.Attempts == nil {
+			details.Attempts = []*AttemptDetails{}
+		}
+		if delivery.Status == DeliveryPending {
+			details.NextAttemptAt = formatNullTime(delivery.NextAttemptAt)
+		}
+		details.DeliveredAt = formatNullTime(delivery.DeliveredAt)
+
+		listOfDeliveries.Deliveries = append(listOfDeliveries.Deliveries, details)
+	}
+
+	totalItems, err := svc.repo.CountDeliveries(ctx, params)
+	if err != nil {
+		return &ListofDeliveries{}, err
+	}
+
+	listOfDeliveries.Meta.TotalPage = utils.CountTotalPage(totalItems, repoQuery.Limit)
+	listOfDeliveries.Meta.HasMore = listOfDeliveries.Meta.Page < listOfDeliveries.Meta.TotalPage
+
+	return listOfDeliveries, nil
+}
+
+// Publish queues a delivery of the event to the subscribers in the workspace
+// of the caller who made the change, they are sent by Dispatch. The change
+// behind the event is already saved, so a failure is only logged.
+func (svc *webhooksService) Publish(ctx context.Context, event string, data any) {
+	caller, ok := auth.FromContext(ctx)
+	if !ok || caller.WorkspaceID == 0 {
+		svc.logger.Error().Str("event", event).Msg("cannot publish webhook event outside a workspace")
+		return
+	}
+
+	// the client may go away as soon as the change is saved
+	ctx = context.WithoutCancel(ctx)
+
+	payload, err := json.Marshal(&WebhookEventPayload{
+		Event:      event,
+		OccurredAt: time.Now().UTC().Format(time.RFC3339),
+		Data:       data,
+	})
+	if err == nil {
+		err = svc.repo.Enqueue(ctx, caller.WorkspaceID, event, payload)
+	}
+
+	if err != nil {
+		svc.logger.Error().Err(err).Str("event", event).Msg("failed to publish webhook event")
+	}
+}
+
+// Dispatch sends the deliveries that are ready. It is run periodically in
+// the background.
+func (svc *webhooksService) Dispatch(ctx context.Context) (err error) {
+	deliveries, err := svc.repo.ClaimDeliveries(ctx, deliverBatchSize, deliveryLease)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		if err = svc.deliver(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliver makes one signed attempt to send a delivery and records it. A
+// failed attempt is retried with exponential backoff until the attempts run
+// out.
+func (svc *webhooksService) deliver(ctx context.Context, delivery *PendingDeliveries) error {
+	timestamp := time.Now().Unix()
+
+	header := http.Header{}
+	header.Set("X-Webhook-Event", delivery.Event)
+	header.Set("X-Webhook-Delivery", strconv.FormatInt(delivery.DeliveryID, 10))
+	header.Set("X-Webhook-Timestamp", strconv.FormatInt(timestamp, 10))
+	header.Set("X-Webhook-Signature", "sha256="+webhook.Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	started := time.Now()
+	statusCode, err := svc.client.Post(ctx, delivery.URL, delivery.Payload, header)
+
+	// the server is shutting down, the lease runs out and the attempt is
+	// made again later without counting this one
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	delivery.Attempts++
+	attempt := &Attempts{
+		Attempt:    delivery.Attempts,
+		StatusCode: sql.NullInt64{Int64: int64(statusCode), Valid: statusCode != 0},
+		DurationMS: time.Since(started).Milliseconds(),
+	}
+	delivery.NextAttemptAt = sql.NullTime{}
+
+	switch {
+	case err == nil:
+		delivery.Status = DeliverySucceeded
+	case delivery.Attempts >= maxDeliveryAttempts:
+		delivery.Status = DeliveryFailed
+		attempt.Error = err.Error()
+	default:
+		delivery.Status = DeliveryPending
+		attempt.Error = err.Error()
+		delivery.NextAttemptAt = sql.NullTime{Time: time.Now().Add(webhook.Backoff(delivery.Attempts, retryBaseDelay, retryMaxDelay)), Valid: true}
+	}
+
+	return svc.repo.RecordAttempt(ctx, delivery, attempt)
+}
+
+// preparePayload checks the subscribed events, drops duplicates and defaults
+// a new subscription to active.
+func preparePayload(payload *WebhookRequestPayload) error {
+	subscribed := []string{}
+	for _, event := range payload.Events {
+		if !slices.Contains(events.Types, event) {
+			return exceptions.NewInvariantError(fmt.Sprintf("%s is not an event, use one of %s", event, strings.Join(events.Types, ", ")))
+		}
+		if !slices.Contains(subscribed, event) {
+			subscribed = append(subscribed, event)
+		}
+	}
+	payload.Events = subscribed
+
+	if payload.Active == nil {
+		active := true
+		payload.Active = &active
+	}
+
+	return nil
+}
+
+func toWebhookDetails(hook *Webhooks) *WebhookDetails {
+	return &WebhookDetails{
+		WebhookID: hook.WebhookID,
+		URL:       hook.URL,
+		Events:    hook.Events,
+		Active:    hook.Active,
+		CreatedAt: hook.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: hook.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func formatNullTime(value sql.NullTime) *string {
+	if !value.Valid {
+		return nil
+	}
+
+	formatted := value.Time.Format(time.RFC3339)
+	return &formatted
+}
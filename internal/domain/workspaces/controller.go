@@ -0,0 +1,36 @@
+package workspaces
+
+import (
+	"github.com/agungramananda/sosmed-todolist/internal/common/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+type WorkspacesController struct {
+	svc WorkspacesService
+}
+
+func NewController(svc WorkspacesService) *WorkspacesController {
+	return &WorkspacesController{
+		svc: svc,
+	}
+}
+
+const (
+	workspacesBasepath = "/workspaces"
+	// membersBasepath holds the members of the workspace a request works in.
+	membersBasepath = "/members"
+)
+
+func (con *WorkspacesController) Route(grp *echo.Group){
+	subrouter := grp.Group(workspacesBasepath, middleware.RequireSession())
+
+	subrouter.GET("", HandleGetAllWorkspaces(con.svc.GetAll))
+	subrouter.POST("", HandleCreateWorkspaces(con.svc.Create))
+
+	membersrouter := grp.Group(membersBasepath, middleware.RequireSession())
+
+	membersrouter.GET("", HandleGetAllMembers(con.svc.GetMembers))
+	membersrouter.POST("", HandleAddMembers(con.svc.AddMember))
+	membersrouter.PUT("/:user_id", HandleUpdateMembers(con.svc.UpdateMember))
+	membersrouter.DELETE("/:user_id", HandleDeleteMembers(con.svc.DeleteMember))
+}
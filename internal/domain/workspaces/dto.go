@@ -0,0 +1,43 @@
+package workspaces
+
+type WorkspaceRequestPayload struct {
+	Name string `json:"name" validate:"required,max=100" example:"Dina's team"`
+}
+
+type MemberRequestParams struct {
+	UserID string `param:"user_id" validate:"required"`
+}
+
+type MemberRequestPayload struct {
+	// Email is the address of the account to add, it must be registered.
+	Email string `json:"email" validate:"required,email,max=254" example:"raka@example.com"`
+	Role  string `json:"role" validate:"required,oneof=owner manager creator viewer" example:"creator"`
+}
+
+type RolePayload struct {
+	Role string `json:"role" validate:"required,oneof=owner manager creator viewer" example:"manager"`
+}
+
+type WorkspaceDetails struct {
+	WorkspaceID int64  `json:"workspace_id"`
+	Name        string `json:"name" example:"Dina's team"`
+	// Role is the role of the caller in the workspace.
+	Role      string `json:"role" example:"owner"`
+	CreatedAt string `json:"created_at" example:"2026-10-18T09:00:00Z"`
+}
+
+type ListofWorkspaces struct {
+	Workspaces []*WorkspaceDetails `json:"workspaces"`
+}
+
+type MemberDetails struct {
+	UserID   int64  `json:"user_id"`
+	Email    string `json:"email" example:"raka@example.com"`
+	Name     string `json:"name" example:"Raka"`
+	Role     string `json:"role" example:"creator"`
+	JoinedAt string `json:"joined_at" example:"2026-10-18T09:30:00Z"`
+}
+
+type ListofMembers struct {
+	Members []*MemberDetails `json:"members"`
+}
@@ -0,0 +1,216 @@
+package workspaces
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/agungramananda/sosmed-todolist/internal/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type GetAllWorkspacesHandler func(context.Context) (*ListofWorkspaces, error)
+type CreateWorkspacesHandler func(context.Context, *WorkspaceRequestPayload) (*WorkspaceDetails, error)
+type GetAllMembersHandler func(context.Context) (*ListofMembers, error)
+type AddMembersHandler func(context.Context, *MemberRequestPayload) error
+type UpdateMembersHandler func(context.Context, *MemberRequestParams, *RolePayload) error
+type DeleteMembersHandler func(context.Context, *MemberRequestParams) error
+
+// Get All Workspaces godoc
+//
+//	@Summary		Get the workspaces of the logged in user
+//	@Description	Send the ID of one as the X-Workspace-ID header to work in it.
+//	@Tags			Workspace
+//	@Produce		json
+//	@Success		200	{object}	ListofWorkspaces		"Successfully fetched all workspaces"
+//	@Failure		401	{object}	httpres.ErrorResponse	"Missing or invalid access token"
+//	@Failure		403	{object}	httpres.ErrorResponse	"Called with an API key"
+//	@Failure		500	{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/workspaces [get]
+func HandleGetAllWorkspaces(handler GetAllWorkspacesHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		data, err := handler(ctx)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "All workspaces fetched successfully")
+	}
+}
+
+// Create Workspace godoc
+//
+//	@Summary	Create a workspace owned by the logged in user
+//	@Tags		Workspace
+//	@Accept		json
+//	@Produce	json
+//	@Param		body	body		WorkspaceRequestPayload	true	"Workspace details"
+//	@Success	201		{object}	WorkspaceDetails		"Workspace successfully created"
+//	@Failure	400		{object}	httpres.ErrorResponse	"Bad request"
+//	@Failure	401		{object}	httpres.ErrorResponse	"Missing or invalid access token"
+//	@Failure	403		{object}	httpres.ErrorResponse	"Called with an API key"
+//	@Failure	500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router		/workspaces [post]
+func HandleCreateWorkspaces(handler CreateWorkspacesHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		payload := &WorkspaceRequestPayload{}
+
+		if err := c.Bind(payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		data, err := handler(ctx, payload)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusCreated, data, "New workspace successfully added")
+	}
+}
+
+// Get All Members godoc
+//
+//	@Summary		Get the members of the workspace
+//	@Description	Only owners and managers may list the members.
+//	@Tags			Workspace
+//	@Produce		json
+//	@Success		200	{object}	ListofMembers			"Successfully fetched all members"
+//	@Failure		401	{object}	httpres.ErrorResponse	"Missing or invalid access token"
+//	@Failure		403	{object}	httpres.ErrorResponse	"Role may not list members"
+//	@Failure		500	{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/members [get]
+func HandleGetAllMembers(handler GetAllMembersHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		data, err := handler(ctx)
+		if err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, data, "All members fetched successfully")
+	}
+}
+
+// Add Member godoc
+//
+//	@Summary		Add a registered user to the workspace
+//	@Description	Only owners may add members.
+//	@Tags			Workspace
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		MemberRequestPayload	true	"Member details"
+//	@Success		201		{object}	httpres.BaseResponse	"Member successfully added"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request or already a member"
+//	@Failure		401		{object}	httpres.ErrorResponse	"Missing or invalid access token"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Role may not add members"
+//	@Failure		404		{object}	httpres.ErrorResponse	"User not found"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/members [post]
+func HandleAddMembers(handler AddMembersHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		payload := &MemberRequestPayload{}
+
+		if err := c.Bind(payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusCreated, nil, "New member successfully added")
+	}
+}
+
+// Update Member godoc
+//
+//	@Summary		Change the role of a member
+//	@Description	Only owners may change roles, and the last owner cannot give up the owner role.
+//	@Tags			Workspace
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		string					true	"User ID"
+//	@Param			body	body		RolePayload				true	"New role"
+//	@Success		200		{object}	httpres.BaseResponse	"Member updated successfully"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request or last owner"
+//	@Failure		401		{object}	httpres.ErrorResponse	"Missing or invalid access token"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Role may not change roles"
+//	@Failure		404		{object}	httpres.ErrorResponse	"Member not found"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/members/{user_id} [put]
+func HandleUpdateMembers(handler UpdateMembersHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &MemberRequestParams{}
+		payload := &RolePayload{}
+
+		if err := (&echo.DefaultBinder{}).BindPathParams(c, params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := (&echo.DefaultBinder{}).BindBody(c, payload); err != nil {
+			return err
+		}
+
+		if err := c.Validate(payload); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params, payload); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Member updated successfully")
+	}
+}
+
+// Delete Member godoc
+//
+//	@Summary		Remove a member from the workspace
+//	@Description	Only owners may remove members, the API keys of the member in the workspace are revoked.
+//	@Tags			Workspace
+//	@Produce		json
+//	@Param			user_id	path		string					true	"User ID"
+//	@Success		200		{object}	httpres.BaseResponse	"Member removed successfully"
+//	@Failure		400		{object}	httpres.ErrorResponse	"Bad request or last owner"
+//	@Failure		401		{object}	httpres.ErrorResponse	"Missing or invalid access token"
+//	@Failure		403		{object}	httpres.ErrorResponse	"Role may not remove members"
+//	@Failure		404		{object}	httpres.ErrorResponse	"Member not found"
+//	@Failure		500		{object}	httpres.ErrorResponse	"Internal server error"
+//	@Router			/members/{user_id} [delete]
+func HandleDeleteMembers(handler DeleteMembersHandler) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		params := &MemberRequestParams{}
+
+		if err := c.Bind(params); err != nil {
+			return err
+		}
+
+		if err := c.Validate(params); err != nil {
+			return err
+		}
+
+		if err := handler(ctx, params); err != nil {
+			return err
+		}
+
+		return utils.WriteResponse(c, http.StatusOK, nil, "Member removed successfully")
+	}
+}
@@ -0,0 +1,29 @@
+package workspaces
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Workspaces struct {
+	WorkspaceID int64     `db:"workspace_id"`
+	Name        string    `db:"name"`
+	Role        string    `db:"role"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type Members struct {
+	UserID    int64     `db:"user_id"`
+	Email     string    `db:"email"`
+	Name      string    `db:"name"`
+	Role      string    `db:"role"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Memberships is a user with the workspace a request works in, the workspace
+// is null when the user is not a member of it.
+type Memberships struct {
+	UserID      int64          `db:"user_id"`
+	WorkspaceID sql.NullInt64  `db:"workspace_id"`
+	Role        sql.NullString `db:"role"`
+}
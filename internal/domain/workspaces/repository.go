@@ -0,0 +1,319 @@
+package workspaces
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/jmoiron/sqlx"
+)
+
+var pgSquirell = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+
+type WorkspacesRepository interface {
+	GetAll(context.Context, int64) ([]*Workspaces, error)
+	GetByID(context.Context, int64, int64) (*Workspaces, error)
+	Add(context.Context, int64, *WorkspaceRequestPayload) (int64, error)
+	GetMembership(context.Context, int64, int64) (*Memberships, error)
+	GetMembers(context.Context, int64) ([]*Members, error)
+	AddMember(context.Context, int64, *MemberRequestPayload) error
+	UpdateMember(context.Context, int64, *MemberRequestParams, *RolePayload) error
+	DeleteMember(context.Context, int64, *MemberRequestParams) error
+}
+
+type workspacesRepository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) WorkspacesRepository {
+	return &workspacesRepository{
+		db: db,
+	}
+}
+
+// GetAll lists the workspaces a user is a member of, with their role in each.
+func (r *workspacesRepository) GetAll(ctx context.Context, userID int64) (resp []*Workspaces, err error) {
+	stmt, args, _ := pgSquirell.Select("w.workspace_id", "w.name", "m.role", "w.created_at").
+						From("workspaces w").
+						Join("workspace_members m on w.workspace_id=m.workspace_id").
+						Where(squirrel.Eq{"m.user_id": userID, "w.deleted_at": nil}).
+						OrderBy("m.created_at ASC", "w.workspace_id ASC").
+						ToSql()
+
+	resp = []*Workspaces{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (r *workspacesRepository) GetByID(ctx context.Context, userID int64, workspaceID int64) (resp *Workspaces, err error) {
+	stmt, args, _ := pgSquirell.Select("w.workspace_id", "w.name", "m.role", "w.created_at").
+						From("workspaces w").
+						Join("workspace_members m on w.workspace_id=m.workspace_id").
+						Where(squirrel.Eq{"m.user_id": userID, "w.workspace_id": workspaceID, "w.deleted_at": nil}).
+						ToSql()
+
+	resp = &Workspaces{}
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).StructScan(resp)
+	if err != nil && err != sql.ErrNoRows {
+		return resp, err
+	} else if err == sql.ErrNoRows {
+		return resp, exceptions.NewNotFoundError("workspaces not found")
+	}
+
+	return resp, nil
+}
+
+// Add creates a workspace owned by the user creating it.
+func (r *workspacesRepository) Add(ctx context.Context, userID int64, payload *WorkspaceRequestPayload) (workspaceID int64, err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer tx.Rollback()
+
+	stmt, args, _ := pgSquirell.Insert("workspaces").Columns("name").Values(payload.Name).Suffix("RETURNING workspace_id").ToSql()
+
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&workspaceID)
+	if err != nil {
+		return 0, err
+	}
+
+	stmt, args, _ = pgSquirell.Insert("workspace_members").Columns("workspace_id", "user_id", "role").Values(workspaceID, userID, "owner").ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return workspaceID, nil
+}
+
+// GetMembership finds a user who is not deleted together with their role in
+// a workspace, or in the workspace they joined first when workspaceID is 0.
+// The workspace is left null when the user is not a member of it.
+func (r *workspacesRepository) GetMembership(ctx context.Context, userID int64, workspaceID int64) (resp *Memberships, err error) {
+	on := squirrel.And{
+		squirrel.Expr("m.user_id=u.user_id"),
+		squirrel.Expr("m.workspace_id IN (SELECT workspace_id FROM workspaces WHERE deleted_at IS NULL)"),
+	}
+	if workspaceID != 0 {
+		on = append(on, squirrel.Eq{"m.workspace_id": workspaceID})
+	}
+
+	onSql, onArgs, _ := on.ToSql()
+
+	stmt, args, _ := pgSquirell.Select("u.user_id", "m.workspace_id", "m.role").
+						From("users u").
+						LeftJoin("workspace_members m on "+onSql, onArgs...).
+						Where(squirrel.Eq{"u.user_id": userID, "u.deleted_at": nil}).
+						OrderBy("m.created_at ASC", "m.workspace_id ASC").
+						Limit(1).
+						ToSql()
+
+	resp = &Memberships{}
+
+	err = r.db.QueryRowxContext(ctx, stmt, args...).StructScan(resp)
+	if err != nil && err != sql.ErrNoRows {
+		return resp, err
+	} else if err == sql.ErrNoRows {
+		return resp, exceptions.NewNotFoundError("users not found")
+	}
+
+	return resp, nil
+}
+
+func (r *workspacesRepository) GetMembers(ctx context.Context, workspaceID int64) (resp []*Members, err error) {
+	stmt, args, _ := pgSquirell.Select("u.user_id", "u.email", "u.name", "m.role", "m.created_at").
+						From("workspace_members m").
+						Join("users u on m.user_id=u.user_id").
+						Where(squirrel.Eq{"m.workspace_id": workspaceID, "u.deleted_at": nil}).
+						OrderBy("m.created_at ASC", "u.user_id ASC").
+						ToSql()
+
+	resp = []*Members{}
+
+	err = r.db.SelectContext(ctx, &resp, stmt, args...)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// AddMember adds the registered user with the given email to a workspace.
+func (r *workspacesRepository) AddMember(ctx context.Context, workspaceID int64, payload *MemberRequestPayload) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	var userID int64
+
+	stmt, args, _ := pgSquirell.Select("user_id").From("users").Where(squirrel.And{squirrel.Eq{"deleted_at": nil}, squirrel.Expr("lower(email) = lower(?)", payload.Email)}).ToSql()
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&userID)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	} else if err == sql.ErrNoRows {
+		return exceptions.NewNotFoundError("users not found")
+	}
+
+	stmt, args, _ = pgSquirell.Insert("workspace_members").
+						Columns("workspace_id", "user_id", "role").
+						Values(workspaceID, userID, payload.Role).
+						Suffix("ON CONFLICT DO NOTHING").
+						ToSql()
+
+	result, err := tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewInvariantError("user is already a member of the workspace")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateMember changes the role of a member. The owners are locked while the
+// role changes, so two owners demoting each other cannot leave none behind.
+func (r *workspacesRepository) UpdateMember(ctx context.Context, workspaceID int64, params *MemberRequestParams, payload *RolePayload) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	if err = lockOwners(ctx, tx, workspaceID); err != nil {
+		return err
+	}
+
+	stmt, args, _ := pgSquirell.Update("workspace_members").
+						Set("role", payload.Role).
+						Where(squirrel.Eq{"workspace_id": workspaceID, "user_id": params.UserID}).
+						ToSql()
+
+	result, err := tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("members not found")
+	}
+
+	if err = checkOwners(ctx, tx, workspaceID); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteMember removes a member from a workspace, the last owner cannot be
+// removed.
+func (r *workspacesRepository) DeleteMember(ctx context.Context, workspaceID int64, params *MemberRequestParams) (err error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	if err = lockOwners(ctx, tx, workspaceID); err != nil {
+		return err
+	}
+
+	stmt, args, _ := pgSquirell.Delete("workspace_members").Where(squirrel.Eq{"workspace_id": workspaceID, "user_id": params.UserID}).ToSql()
+
+	result, err := tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return exceptions.NewNotFoundError("members not found")
+	}
+
+	// the keys of a removed member stop working with the membership, revoke
+	// them so they do not come back if the member is added again
+	stmt, args, _ = pgSquirell.Update("api_keys").
+						Set("revoked_at", squirrel.Expr("NOW()")).
+						Where(squirrel.Eq{"workspace_id": workspaceID, "user_id": params.UserID, "revoked_at": nil}).
+						ToSql()
+
+	if _, err = tx.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+
+	// a removed member is no longer responsible for the tasks of the workspace
+	for _, column := range []string{"assignee_id", "reviewer_id"} {
+		stmt, args, _ = pgSquirell.Update("tasks").
+							Set(column, nil).
+							Where(squirrel.Eq{"workspace_id": workspaceID, column: params.UserID}).
+							ToSql()
+
+		if _, err = tx.ExecContext(ctx, stmt, args...); err != nil {
+			return err
+		}
+	}
+
+	if err = checkOwners(ctx, tx, workspaceID); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func lockOwners(ctx context.Context, tx *sqlx.Tx, workspaceID int64) (err error) {
+	stmt, args, _ := pgSquirell.Select("user_id").From("workspace_members").Where(squirrel.Eq{"workspace_id": workspaceID, "role": "owner"}).Suffix("FOR UPDATE").ToSql()
+
+	_, err = tx.ExecContext(ctx, stmt, args...)
+	return err
+}
+
+func checkOwners(ctx context.Context, tx *sqlx.Tx, workspaceID int64) (err error) {
+	var owners int64
+
+	stmt, args, _ := pgSquirell.Select("count(*)").From("workspace_members").Where(squirrel.Eq{"workspace_id": workspaceID, "role": "owner"}).ToSql()
+	err = tx.QueryRowxContext(ctx, stmt, args...).Scan(&owners)
+	if err != nil {
+		return err
+	} else if owners == 0 {
+		return exceptions.NewInvariantError("a workspace needs an owner, make someone else owner first")
+	}
+
+	return nil
+}
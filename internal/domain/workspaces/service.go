@@ -0,0 +1,159 @@
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/auth"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+)
+
+type WorkspacesService interface {
+	GetAll(context.Context) (*ListofWorkspaces, error)
+	Create(context.Context, *WorkspaceRequestPayload) (*WorkspaceDetails, error)
+	GetMembers(context.Context) (*ListofMembers, error)
+	AddMember(context.Context, *MemberRequestPayload) error
+	UpdateMember(context.Context, *MemberRequestParams, *RolePayload) error
+	DeleteMember(context.Context, *MemberRequestParams) error
+	Authenticate(context.Context, int64, int64) (*auth.User, error)
+}
+
+type workspacesService struct {
+	repo WorkspacesRepository
+}
+
+func NewService(r WorkspacesRepository) *workspacesService {
+	return &workspacesService{repo: r}
+}
+
+func (svc *workspacesService) GetAll(ctx context.Context) (listOfWorkspaces *ListofWorkspaces, err error) {
+	caller, err := auth.Caller(ctx)
+	if err != nil {
+		return listOfWorkspaces, err
+	}
+
+	workspaces, err := svc.repo.GetAll(ctx, caller.ID)
+	if err != nil {
+		return listOfWorkspaces, err
+	}
+
+	listOfWorkspaces = &ListofWorkspaces{
+		Workspaces: []*WorkspaceDetails{},
+	}
+
+	for _, workspace := range workspaces {
+		listOfWorkspaces.Workspaces = append(listOfWorkspaces.Workspaces, toWorkspaceDetails(workspace))
+	}
+
+	return listOfWorkspaces, nil
+}
+
+// Create makes a workspace with the caller as its owner.
+func (svc *workspacesService) Create(ctx context.Context, payload *WorkspaceRequestPayload) (workspaceDetails *WorkspaceDetails, err error) {
+	caller, err := auth.Caller(ctx)
+	if err != nil {
+		return workspaceDetails, err
+	}
+
+	workspaceID, err := svc.repo.Add(ctx, caller.ID, payload)
+	if err != nil {
+		return workspaceDetails, err
+	}
+
+	workspace, err := svc.repo.GetByID(ctx, caller.ID, workspaceID)
+	if err != nil {
+		return workspaceDetails, err
+	}
+
+	return toWorkspaceDetails(workspace), nil
+}
+
+func (svc *workspacesService) GetMembers(ctx context.Context) (listOfMembers *ListofMembers, err error) {
+	caller, err := auth.Authorize(ctx, auth.PermViewMembers)
+	if err != nil {
+		return listOfMembers, err
+	}
+
+	members, err := svc.repo.GetMembers(ctx, caller.WorkspaceID)
+	if err != nil {
+		return listOfMembers, err
+	}
+
+	listOfMembers = &ListofMembers{
+		Members: []*MemberDetails{},
+	}
+
+	for _, member := range members {
+		listOfMembers.Members = append(listOfMembers.Members, &MemberDetails{
+			UserID:   member.UserID,
+			Email:    member.Email,
+			Name:     member.Name,
+			Role:     member.Role,
+			JoinedAt: member.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return listOfMembers, nil
+}
+
+func (svc *workspacesService) AddMember(ctx context.Context, payload *MemberRequestPayload) (err error) {
+	caller, err := auth.Authorize(ctx, auth.PermManageMembers)
+	if err != nil {
+		return err
+	}
+
+	return svc.repo.AddMember(ctx, caller.WorkspaceID, payload)
+}
+
+func (svc *workspacesService) UpdateMember(ctx context.Context, params *MemberRequestParams, payload *RolePayload) (err error) {
+	caller, err := auth.Authorize(ctx, auth.PermManageMembers)
+	if err != nil {
+		return err
+	}
+
+	return svc.repo.UpdateMember(ctx, caller.WorkspaceID, params, payload)
+}
+
+func (svc *workspacesService) DeleteMember(ctx context.Context, params *MemberRequestParams) (err error) {
+	caller, err := auth.Authorize(ctx, auth.PermManageMembers)
+	if err != nil {
+		return err
+	}
+
+	return svc.repo.DeleteMember(ctx, caller.WorkspaceID, params)
+}
+
+// Authenticate resolves the user an access token was issued to with their
+// role in the workspace the request works in, which is the workspace they
+// joined first unless workspaceID picks one. A deleted user is refused even
+// while their token is valid.
+func (svc *workspacesService) Authenticate(ctx context.Context, userID int64, workspaceID int64) (user *auth.User, err error) {
+	membership, err := svc.repo.GetMembership(ctx, userID, workspaceID)
+	if err != nil && !errors.As(err, &exceptions.NotFoundError{}) {
+		return user, err
+	} else if err != nil {
+		return user, exceptions.NewUnauthorizedError("invalid or expired token")
+	}
+
+	if workspaceID != 0 && !membership.WorkspaceID.Valid {
+		return user, exceptions.NewForbiddenError("you are not a member of this workspace")
+	}
+
+	user = &auth.User{
+		ID:          membership.UserID,
+		WorkspaceID: membership.WorkspaceID.Int64,
+		Role:        membership.Role.String,
+	}
+
+	return user, nil
+}
+
+func toWorkspaceDetails(workspace *Workspaces) *WorkspaceDetails {
+	return &WorkspaceDetails{
+		WorkspaceID: workspace.WorkspaceID,
+		Name:        workspace.Name,
+		Role:        workspace.Role,
+		CreatedAt:   workspace.CreatedAt.Format(time.RFC3339),
+	}
+}
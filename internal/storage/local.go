@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+type localStorage struct {
+	dir string
+}
+
+// NewLocal returns a Storage that writes objects below dir on the local
+// filesystem, creating the directory if needed.
+func NewLocal(dir string) (Storage, error) {
+	if dir == "" {
+		return nil, errors.New("storage directory is not configured")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &localStorage{
+		dir: dir,
+	}, nil
+}
+
+// path maps a key to a file below the storage directory. Cleaning the key as
+// a rooted path strips any ".." so a key can never escape the directory.
+func (s *localStorage) path(key string) string {
+	return filepath.Join(s.dir, filepath.Clean("/"+key))
+}
+
+func (s *localStorage) Put(ctx context.Context, key string, content io.Reader) error {
+	path := s.path(key)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// write to a temporary file first so a failed upload never leaves a
+	// partial object behind under the final key
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *localStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+
+	return file, err
+}
+
+func (s *localStorage) Delete(ctx context.Context, key string) error {
+	err := os.Remove(s.path(key))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("stored object not found")
+
+// Storage keeps the binary content of uploaded files, addressed by a key that
+// the caller generates. Metadata lives in the database, not in the backend.
+type Storage interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
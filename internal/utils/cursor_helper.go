@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/agungramananda/sosmed-todolist/internal/common/httpres"
+)
+
+// CursorTimeFormat is used to render timestamp sort values inside a cursor in
+// a form Postgres parses back without losing precision.
+const CursorTimeFormat = "2006-01-02 15:04:05.999999"
+
+// Cursor is the decoded form of the opaque next_cursor/prev_cursor values. It
+// holds the sort key values of the row the page starts after (or before, when
+// Backward is set) and the sort it was issued for.
+type Cursor struct {
+	Sort     string   `json:"s"`
+	Values   []string `json:"v"`
+	Backward bool     `json:"b,omitempty"`
+}
+
+func EncodeCursor(cursor Cursor) string {
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor parses a cursor and checks it was issued for the given sort.
+func DecodeCursor(raw string, fields []SortField) (*Cursor, error) {
+	invalid := exceptions.NewInvariantError("cursor is invalid")
+
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, invalid
+	}
+
+	cursor := &Cursor{}
+	if err = json.Unmarshal(decoded, cursor); err != nil {
+		return nil, invalid
+	}
+
+	if cursor.Sort != SortKey(fields) || len(cursor.Values) != len(fields) {
+		return nil, exceptions.NewInvariantError("cursor does not match the requested sort")
+	}
+
+	return cursor, nil
+}
+
+// SortKey renders sort fields back into the canonical sort expression.
+func SortKey(fields []SortField) string {
+	keys := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		if field.Desc {
+			keys = append(keys, "-"+field.Key)
+		} else {
+			keys = append(keys, field.Key)
+		}
+	}
+
+	return strings.Join(keys, ",")
+}
+
+// ReverseSort flips the direction of every field, used to walk backwards from
+// a prev_cursor.
+func ReverseSort(fields []SortField) []SortField {
+	reversed := make([]SortField, len(fields))
+
+	for i, field := range fields {
+		field.Desc = !field.Desc
+		reversed[i] = field
+	}
+
+	return reversed
+}
+
+// KeysetPredicate selects the rows that come strictly after values in the
+// given sort order, i.e. (a > va) OR (a = va AND b > vb) OR ...
+func KeysetPredicate(fields []SortField, values []string) squirrel.Sqlizer {
+	predicate := squirrel.Or{}
+
+	for i, field := range fields {
+		branch := squirrel.And{}
+
+		for j := 0; j < i; j++ {
+			branch = append(branch, squirrel.Eq{fields[j].Column: values[j]})
+		}
+
+		if field.Desc {
+			branch = append(branch, squirrel.Lt{field.Column: values[i]})
+		} else {
+			branch = append(branch, squirrel.Gt{field.Column: values[i]})
+		}
+
+		predicate = append(predicate, branch)
+	}
+
+	return predicate
+}
+
+// PaginateKeyset trims the look-ahead row fetched by the repository (which
+// queries limit+1 rows), restores the natural order of a backward page and
+// fills the cursor fields of meta. HasMore reports whether more rows exist in
+// the direction the client is travelling.
+func PaginateKeyset[T any](rows []T, meta *httpres.ListPagination, fields []SortField, cursor *Cursor, value func(T, string) string) []T {
+	backward := cursor != nil && cursor.Backward
+
+	meta.HasMore = uint64(len(rows)) > meta.Limit
+	if meta.HasMore {
+		rows = rows[:meta.Limit]
+	}
+
+	if backward {
+		slices.Reverse(rows)
+	}
+
+	if len(rows) == 0 {
+		return rows
+	}
+
+	encode := func(row T, backward bool) string {
+		values := make([]string, 0, len(fields))
+		for _, field := range fields {
+			values = append(values, value(row, field.Key))
+		}
+
+		return EncodeCursor(Cursor{Sort: SortKey(fields), Values: values, Backward: backward})
+	}
+
+	if backward {
+		meta.NextCursor = encode(rows[len(rows)-1], false)
+		if meta.HasMore {
+			meta.PrevCursor = encode(rows[0], true)
+		}
+	} else {
+		if meta.HasMore {
+			meta.NextCursor = encode(rows[len(rows)-1], false)
+		}
+		if cursor != nil || meta.Page > 1 {
+			meta.PrevCursor = encode(rows[0], true)
+		}
+	}
+
+	return rows
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+)
+
+// NormalizeHashtags lowercases hashtags, strips the leading '#' and drops
+// blanks and duplicates so "#Summer" and "summer" are stored and searched as
+// the same tag. It never returns nil, the column is NOT NULL.
+func NormalizeHashtags(hashtags []string) ([]string, error) {
+	normalized := []string{}
+	seen := map[string]bool{}
+
+	for _, hashtag := range hashtags {
+		hashtag = strings.ToLower(strings.TrimLeft(strings.TrimSpace(hashtag), "#"))
+		if hashtag == "" || seen[hashtag] {
+			continue
+		}
+
+		if strings.IndexFunc(hashtag, unicode.IsSpace) >= 0 {
+			return nil, exceptions.NewInvariantError(fmt.Sprintf("hashtag %q must not contain spaces", hashtag))
+		}
+
+		seen[hashtag] = true
+		normalized = append(normalized, hashtag)
+	}
+
+	return normalized, nil
+}
@@ -1,6 +1,10 @@
 package utils
 
 import (
+	"io"
+	"mime"
+	"net/http"
+
 	"github.com/agungramananda/sosmed-todolist/internal/common/httpres"
 	"github.com/labstack/echo/v4"
 )
@@ -10,4 +14,30 @@ func WriteResponse(c echo.Context, code int, data any, msg string) error{
 		Data: data,
 		Message: msg,
 	})
-}
\ No newline at end of file
+}
+
+const MIMETextCSV = "text/csv; charset=utf-8"
+
+// WriteStream streams a non-JSON body produced by write. The headers are only
+// set once write produces output, so an error returned before that is still
+// rendered as a regular JSON error response.
+func WriteStream(c echo.Context, contentType string, filename string, disposition string, write func(io.Writer) error) error {
+	return write(&streamWriter{c: c, contentType: contentType, disposition: mime.FormatMediaType(disposition, map[string]string{"filename": filename})})
+}
+
+type streamWriter struct {
+	c           echo.Context
+	contentType string
+	disposition string
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	res := w.c.Response()
+	if !res.Committed {
+		res.Header().Set(echo.HeaderContentType, w.contentType)
+		res.Header().Set(echo.HeaderContentDisposition, w.disposition)
+		res.WriteHeader(http.StatusOK)
+	}
+
+	return res.Write(p)
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MIMETextCalendar = "text/calendar; charset=utf-8"
+
+	ICalDateFormat     = "20060102"
+	ICalDateTimeFormat = "20060102T150405Z"
+
+	icalLineLimit = 75
+)
+
+var icalTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// ICalWriter writes RFC 5545 content lines, folding them at 75 octets and
+// terminating them with CRLF. The first write error is kept and returned by
+// Flush so callers can write a whole calendar before checking.
+type ICalWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func NewICalWriter(w io.Writer) *ICalWriter {
+	return &ICalWriter{w: bufio.NewWriter(w)}
+}
+
+// Property writes a property whose value is already in iCalendar form, such
+// as a date or a list of escaped texts. name may include parameters.
+func (iw *ICalWriter) Property(name string, value string) {
+	iw.writeLine(name + ":" + value)
+}
+
+// Text writes a property with a TEXT value, escaping it.
+func (iw *ICalWriter) Text(name string, value string) {
+	iw.Property(name, ICalEscape(value))
+}
+
+func (iw *ICalWriter) DateTime(name string, value time.Time) {
+	iw.Property(name, value.UTC().Format(ICalDateTimeFormat))
+}
+
+func (iw *ICalWriter) Date(name string, value time.Time) {
+	iw.Property(name+";VALUE=DATE", value.Format(ICalDateFormat))
+}
+
+func (iw *ICalWriter) Flush() error {
+	if iw.err != nil {
+		return iw.err
+	}
+
+	return iw.w.Flush()
+}
+
+func (iw *ICalWriter) writeLine(line string) {
+	if iw.err != nil {
+		return
+	}
+
+	// continuation lines start with a space, which counts towards the limit
+	limit := icalLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		if _, iw.err = iw.w.WriteString(line[:cut] + "\r\n "); iw.err != nil {
+			return
+		}
+
+		line = line[cut:]
+		limit = icalLineLimit - 1
+	}
+
+	_, iw.err = iw.w.WriteString(line + "\r\n")
+}
+
+func ICalEscape(value string) string {
+	return icalTextEscaper.Replace(value)
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+	"github.com/labstack/echo/v4"
+)
+
+const MIMEApplicationMergePatchJSON = "application/merge-patch+json"
+
+// BindMergePatch decodes an RFC 7386 JSON Merge Patch body into payload, whose
+// fields are expected to be pointers so that absent members stay nil. Null
+// members are rejected since none of the patchable columns can be cleared.
+func BindMergePatch(c echo.Context, payload any) error {
+	contentType := c.Request().Header.Get(echo.HeaderContentType)
+	if !strings.HasPrefix(contentType, echo.MIMEApplicationJSON) && !strings.HasPrefix(contentType, MIMEApplicationMergePatchJSON) {
+		return echo.ErrUnsupportedMediaType
+	}
+
+	members := map[string]json.RawMessage{}
+	if err := json.NewDecoder(c.Request().Body).Decode(&members); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "request body must be a JSON object").SetInternal(err)
+	}
+
+	if len(members) == 0 {
+		return exceptions.NewInvariantError("patch must contain at least one field")
+	}
+
+	for name, value := range members {
+		if string(value) == "null" {
+			return exceptions.NewInvariantError(fmt.Sprintf("%s cannot be null", name))
+		}
+	}
+
+	raw, _ := json.Marshal(members)
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(payload); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	return nil
+}
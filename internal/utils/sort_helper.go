@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/agungramananda/sosmed-todolist/internal/common/exceptions"
+)
+
+type SortField struct {
+	Key    string
+	Column string
+	Desc   bool
+}
+
+// ParseSort turns a sort expression such as "-due_date,title" into sort fields
+// using a whitelist of sortable keys mapped to their SQL columns. The tiebreaker
+// key is always appended (unless already present) so the ordering is stable.
+func ParseSort(sort string, columns map[string]string, tiebreaker string) ([]SortField, error) {
+	fields := []SortField{}
+	seen := map[string]bool{}
+
+	for _, key := range strings.Split(sort, ",") {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+
+		desc := strings.HasPrefix(key, "-")
+		key = strings.TrimPrefix(strings.TrimPrefix(key, "-"), "+")
+
+		column, ok := columns[key]
+		if !ok {
+			return nil, exceptions.NewInvariantError(fmt.Sprintf("cannot sort by %s", key))
+		}
+		if seen[key] {
+			return nil, exceptions.NewInvariantError(fmt.Sprintf("%s is sorted more than once", key))
+		}
+		seen[key] = true
+
+		fields = append(fields, SortField{Key: key, Column: column, Desc: desc})
+	}
+
+	if !seen[tiebreaker] {
+		fields = append(fields, SortField{Key: tiebreaker, Column: columns[tiebreaker]})
+	}
+
+	return fields, nil
+}
+
+func OrderByClauses(fields []SortField) []string {
+	clauses := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		if field.Desc {
+			clauses = append(clauses, field.Column+" DESC")
+		} else {
+			clauses = append(clauses, field.Column+" ASC")
+		}
+	}
+
+	return clauses
+}
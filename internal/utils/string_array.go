@@ -0,0 +1,11 @@
+package utils
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+// StringArray is a []string that can be scanned from a Postgres text[] column
+// through database/sql, which the pgx stdlib driver does not do on its own.
+type StringArray []string
+
+func (a *StringArray) Scan(src any) error {
+	return pgtype.NewMap().SQLScanner((*[]string)(a)).Scan(src)
+}
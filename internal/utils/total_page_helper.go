@@ -8,5 +8,5 @@ func CountTotalPage(total_items uint64, limit uint64) uint64 {
 	if total_items < limit {
 		return 1
 	}
-	return total_items / limit
+	return (total_items + limit - 1) / limit
 }
\ No newline at end of file